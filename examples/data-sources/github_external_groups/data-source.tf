@@ -0,0 +1,4 @@
+data "github_external_groups" "example" {
+  organization = "example-org"
+  display_name = "Engineering"
+}
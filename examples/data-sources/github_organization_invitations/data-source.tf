@@ -0,0 +1,3 @@
+data "github_organization_invitations" "example" {
+  organization = "example-org"
+}
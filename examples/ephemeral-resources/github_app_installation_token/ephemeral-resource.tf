@@ -0,0 +1,9 @@
+ephemeral "github_app_installation_token" "example" {
+  organization = "example-org"
+  repositories = ["example-repo"]
+
+  permissions = {
+    contents      = "read"
+    pull_requests = "write"
+  }
+}
@@ -0,0 +1,11 @@
+locals {
+  repository = provider::github::parse_repository("octo-org/hello-world")
+}
+
+output "repository_owner" {
+  value = local.repository.owner # "octo-org"
+}
+
+output "repository_name" {
+  value = local.repository.name # "hello-world"
+}
@@ -0,0 +1,8 @@
+locals {
+  team = provider::github::parse_team("@octo-org/platform-engineering")
+}
+
+data "github_team" "example" {
+  organization = local.team.organization
+  slug         = local.team.slug
+}
@@ -0,0 +1,11 @@
+locals {
+  url = provider::github::parse_url("https://github.com/octo-org/hello-world/blob/main/README.md")
+}
+
+output "repository" {
+  value = "${local.url.owner}/${local.url.repository}" # "octo-org/hello-world"
+}
+
+output "ref" {
+  value = local.url.ref # "main"
+}
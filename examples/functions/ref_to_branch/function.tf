@@ -0,0 +1,8 @@
+variable "github_ref" {
+  type    = string
+  default = "refs/heads/main"
+}
+
+output "branch" {
+  value = provider::github::ref_to_branch(var.github_ref) # "main"
+}
@@ -0,0 +1,7 @@
+locals {
+  team_name = "Platform Engineering"
+}
+
+output "team_slug" {
+  value = provider::github::team_slug(local.team_name) # "platform-engineering"
+}
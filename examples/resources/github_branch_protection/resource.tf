@@ -0,0 +1,38 @@
+resource "github_team" "example" {
+  organization = "example-org"
+  name         = "example-team"
+}
+
+resource "github_repository" "example" {
+  organization = "example-org"
+  name         = "example-repo"
+  auto_init    = true
+}
+
+resource "github_branch_protection" "example" {
+  organization            = "example-org"
+  repository              = github_repository.example.name
+  branch                  = github_repository.example.default_branch
+  enforce_admins          = true
+  required_linear_history = true
+  required_signatures     = true
+
+  required_pull_request_reviews = {
+    dismiss_stale_reviews           = true
+    require_code_owner_reviews      = true
+    required_approving_review_count = 1
+  }
+
+  required_status_checks = {
+    strict = true
+    checks = [
+      {
+        context = "ci"
+      }
+    ]
+  }
+
+  restrictions = {
+    teams = [github_team.example.slug]
+  }
+}
@@ -0,0 +1,12 @@
+resource "github_organization_membership" "example" {
+  organization = "example-org"
+  username     = "example-user"
+  role         = "admin"
+
+  convert_to_outside_collaborator_on_destroy = true
+}
+
+resource "github_organization_membership" "invitation" {
+  organization = "example-org"
+  email        = "someone@example.com"
+}
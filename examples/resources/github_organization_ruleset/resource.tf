@@ -0,0 +1,65 @@
+resource "github_team" "example" {
+  organization = "example-org"
+  name         = "example-team"
+}
+
+resource "github_organization_property" "example" {
+  organization   = "example-org"
+  name           = "environment"
+  value_type     = "single_select"
+  allowed_values = ["development", "production"]
+}
+
+resource "github_organization_ruleset" "example" {
+  organization = "example-org"
+  name         = "production-default-branch"
+  target       = "branch"
+  enforcement  = "active"
+
+  bypass_actors = [
+    {
+      actor_id    = github_team.example.id
+      actor_type  = "Team"
+      bypass_mode = "pull_request"
+    },
+    {
+      actor_id   = 1
+      actor_type = "OrganizationAdmin"
+    }
+  ]
+
+  conditions = {
+    ref_name = {
+      include = ["~DEFAULT_BRANCH"]
+    }
+
+    repository_property = {
+      include = [
+        {
+          name            = github_organization_property.example.name
+          property_values = ["production"]
+        }
+      ]
+    }
+  }
+
+  rules = {
+    deletion                = true
+    non_fast_forward        = true
+    required_linear_history = true
+
+    pull_request = {
+      required_approving_review_count = 1
+      require_code_owner_review       = true
+    }
+
+    required_status_checks = {
+      strict = true
+      required_checks = [
+        {
+          context = "ci"
+        }
+      ]
+    }
+  }
+}
@@ -0,0 +1,9 @@
+resource "github_repository" "example" {
+  organization           = "example-org"
+  name                   = "example-repository"
+  description            = "An example repository"
+  visibility             = "private"
+  topics                 = ["example", "terraform"]
+  allow_merge_commit     = false
+  delete_branch_on_merge = true
+}
@@ -0,0 +1,32 @@
+resource "github_organization_property" "environment" {
+  organization   = "example-org"
+  name           = "environment"
+  value_type     = "single_select"
+  allowed_values = ["development", "production"]
+}
+
+resource "github_organization_property" "teams" {
+  organization   = "example-org"
+  name           = "teams"
+  value_type     = "multi_select"
+  allowed_values = ["platform", "security", "web"]
+}
+
+resource "github_repository" "example" {
+  organization = "example-org"
+  name         = "example-repository"
+}
+
+resource "github_repository_custom_properties" "example" {
+  organization = "example-org"
+  repository   = github_repository.example.name
+
+  properties = {
+    (github_organization_property.environment.name) = {
+      value = "production"
+    }
+    (github_organization_property.teams.name) = {
+      values = ["platform", "security"]
+    }
+  }
+}
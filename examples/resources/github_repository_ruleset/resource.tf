@@ -0,0 +1,56 @@
+resource "github_team" "example" {
+  organization = "example-org"
+  name         = "example-team"
+}
+
+resource "github_repository" "example" {
+  organization = "example-org"
+  name         = "example-repo"
+}
+
+resource "github_repository_ruleset" "example" {
+  organization = "example-org"
+  repository   = github_repository.example.name
+  name         = "default-branch"
+  target       = "branch"
+  enforcement  = "active"
+
+  bypass_actors = [
+    {
+      actor_id    = github_team.example.id
+      actor_type  = "Team"
+      bypass_mode = "pull_request"
+    }
+  ]
+
+  conditions = {
+    ref_name = {
+      include = ["~DEFAULT_BRANCH"]
+    }
+  }
+
+  rules = {
+    deletion                = true
+    non_fast_forward        = true
+    required_linear_history = true
+    required_signatures     = true
+
+    merge_queue = {
+      merge_method = "SQUASH"
+    }
+
+    pull_request = {
+      required_approving_review_count = 1
+      require_code_owner_review       = true
+    }
+
+    required_status_checks = {
+      strict = true
+      required_checks = [
+        {
+          context = "ci"
+        }
+      ]
+    }
+  }
+}
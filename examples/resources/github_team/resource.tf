@@ -4,3 +4,14 @@ resource "github_team" "example" {
   description  = "An example team"
   privacy      = "closed"
 }
+
+resource "github_team" "reviewers" {
+  organization = "example-org"
+  name         = "example-reviewers"
+
+  code_review_assignment = {
+    algorithm    = "load_balance"
+    member_count = 2
+    notify_team  = false
+  }
+}
@@ -0,0 +1,10 @@
+data "github_external_groups" "example" {
+  organization = "example-org"
+  display_name = "Engineering"
+}
+
+resource "github_team_external_group" "example" {
+  organization = "example-org"
+  team         = "example-team"
+  group_id     = data.github_external_groups.example.external_groups[0].group_id
+}
@@ -0,0 +1,12 @@
+resource "github_team_idp_sync" "example" {
+  organization = "example-org"
+  team         = "example-team"
+
+  groups = [
+    {
+      group_id          = "8f7e6d5c-4b3a-2918-0706-f5e4d3c2b1a0"
+      group_name        = "Engineering"
+      group_description = "All engineers"
+    },
+  ]
+}
@@ -0,0 +1,14 @@
+resource "github_team_members" "example" {
+  organization = "example-org"
+  team         = "example-team"
+
+  members = [
+    {
+      username = "example-maintainer"
+      role     = "maintainer"
+    },
+    {
+      username = "example-user"
+    },
+  ]
+}
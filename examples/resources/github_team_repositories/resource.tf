@@ -0,0 +1,23 @@
+resource "github_team" "example" {
+  organization = "example-org"
+  name         = "example-team"
+}
+
+resource "github_team_repositories" "example" {
+  organization = "example-org"
+  team         = github_team.example.slug
+
+  repositories = [
+    {
+      repository = "example-repo"
+      permission = "push"
+    },
+    {
+      repository = "example-docs"
+      permission = "security-reviewer"
+    },
+    {
+      repository = "example-tools"
+    }
+  ]
+}
@@ -0,0 +1,16 @@
+resource "github_team" "example" {
+  organization = "example-org"
+  name         = "example-team"
+}
+
+resource "github_repository" "example" {
+  organization = "example-org"
+  name         = "example-repo"
+}
+
+resource "github_team_repository" "example" {
+  organization = "example-org"
+  team         = github_team.example.slug
+  repository   = github_repository.example.name
+  permission   = "maintain"
+}
@@ -0,0 +1,234 @@
+package ghfake
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// protection is the state of a branch protection.
+type protection struct {
+	request    github.ProtectionRequest
+	signatures bool
+}
+
+// lookupProtection returns the repository and branch protection for a request.
+func (s *Server) lookupProtection(r *http.Request) (*repository, *protection, bool) {
+	repo, ok := s.lookupRepository(r)
+	if !ok {
+		return nil, nil, false
+	}
+
+	p, ok := repo.protections[r.PathValue("branch")]
+	return repo, p, ok
+}
+
+// branchNotProtected returns the "404 Not Found" response for a branch without protection, which go-github maps to
+// github.ErrBranchNotProtected.
+func branchNotProtected() (int, any) {
+	return http.StatusNotFound, apiError("Branch not protected")
+}
+
+// validateProtection validates the branch protection request, the restrictions must reference existing users and teams.
+func (s *Server) validateProtection(repo *repository, req github.ProtectionRequest) (int, any, bool) {
+	if rev := req.RequiredPullRequestReviews; rev != nil && (rev.RequiredApprovingReviewCount < 0 || rev.RequiredApprovingReviewCount > 6) {
+		status, body := validationFailed("ProtectedBranch", "required_approving_review_count", "invalid", "")
+		return status, body, false
+	}
+
+	if req.Restrictions == nil {
+		return 0, nil, true
+	}
+
+	o, ok := s.organization(repo.repo.GetOwner().GetLogin())
+	if !ok {
+		status, body := validationFailed("ProtectedBranch", "restrictions", "invalid", "Only organization repositories can have users and team restrictions")
+		return status, body, false
+	}
+
+	for _, login := range req.Restrictions.Users {
+		if _, ok := s.user(login); !ok {
+			status, body := validationFailed("ProtectedBranch", "restrictions", "invalid", fmt.Sprintf("No user found with login %s", login))
+			return status, body, false
+		}
+	}
+
+	for _, slug := range req.Restrictions.Teams {
+		if _, ok := o.teamBySlug(slug); !ok {
+			status, body := validationFailed("ProtectedBranch", "restrictions", "invalid", fmt.Sprintf("No team found with slug %s", slug))
+			return status, body, false
+		}
+	}
+
+	return 0, nil, true
+}
+
+// protectionJSON returns the API representation of a branch protection, the restrictions are sorted in the same way as GitHub
+// rather than in the order of the request.
+func (s *Server) protectionJSON(repo *repository, p *protection) *github.Protection {
+	req := p.request
+
+	out := &github.Protection{
+		EnforceAdmins:                  &github.AdminEnforcement{Enabled: req.EnforceAdmins},
+		RequireLinearHistory:           &github.RequireLinearHistory{Enabled: req.GetRequireLinearHistory()},
+		AllowForcePushes:               &github.AllowForcePushes{Enabled: req.GetAllowForcePushes()},
+		AllowDeletions:                 &github.AllowDeletions{Enabled: req.GetAllowDeletions()},
+		RequiredConversationResolution: &github.RequiredConversationResolution{Enabled: req.GetRequiredConversationResolution()},
+		BlockCreations:                 &github.BlockCreations{Enabled: github.Ptr(req.GetBlockCreations())},
+		LockBranch:                     &github.LockBranch{Enabled: github.Ptr(req.GetLockBranch())},
+		AllowForkSyncing:               &github.AllowForkSyncing{Enabled: github.Ptr(req.GetAllowForkSyncing())},
+		RequiredSignatures:             &github.SignaturesProtectedBranch{Enabled: github.Ptr(p.signatures)},
+	}
+
+	if c := req.RequiredStatusChecks; c != nil {
+		checks := []*github.RequiredStatusCheck{}
+		if c.Checks != nil {
+			checks = append(checks, *c.Checks...)
+		} else if c.Contexts != nil {
+			for _, ctx := range *c.Contexts {
+				checks = append(checks, &github.RequiredStatusCheck{Context: ctx})
+			}
+		}
+
+		contexts := make([]string, 0, len(checks))
+		for _, check := range checks {
+			contexts = append(contexts, check.Context)
+		}
+
+		out.RequiredStatusChecks = &github.RequiredStatusChecks{Strict: c.Strict, Contexts: &contexts, Checks: &checks}
+	}
+
+	if rev := req.RequiredPullRequestReviews; rev != nil {
+		out.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcement{
+			DismissStaleReviews:          rev.DismissStaleReviews,
+			RequireCodeOwnerReviews:      rev.RequireCodeOwnerReviews,
+			RequiredApprovingReviewCount: rev.RequiredApprovingReviewCount,
+			RequireLastPushApproval:      rev.GetRequireLastPushApproval(),
+		}
+	}
+
+	if res := req.Restrictions; res != nil {
+		o, _ := s.organization(repo.repo.GetOwner().GetLogin())
+
+		restrictions := &github.BranchRestrictions{Users: []*github.User{}, Teams: []*github.Team{}, Apps: []*github.App{}}
+		for _, login := range res.Users {
+			u, _ := s.user(login)
+			restrictions.Users = append(restrictions.Users, simpleUser(u))
+		}
+		for _, slug := range res.Teams {
+			t, _ := o.teamBySlug(slug)
+			restrictions.Teams = append(restrictions.Teams, &github.Team{ID: github.Ptr(t.id), Name: github.Ptr(t.name), Slug: github.Ptr(t.slug)})
+		}
+		for _, slug := range res.Apps {
+			restrictions.Apps = append(restrictions.Apps, &github.App{Slug: github.Ptr(strings.ToLower(slug)), Name: github.Ptr(slug)})
+		}
+
+		slices.SortFunc(restrictions.Users, func(a, b *github.User) int {
+			return cmp.Compare(strings.ToLower(a.GetLogin()), strings.ToLower(b.GetLogin()))
+		})
+		slices.SortFunc(restrictions.Teams, func(a, b *github.Team) int { return cmp.Compare(a.GetSlug(), b.GetSlug()) })
+		slices.SortFunc(restrictions.Apps, func(a, b *github.App) int { return cmp.Compare(a.GetSlug(), b.GetSlug()) })
+
+		out.Restrictions = restrictions
+	}
+
+	return out
+}
+
+// routeBranchProtections registers the branch protection endpoints.
+func (s *Server) routeBranchProtections() {
+	s.handle("GET /repos/{owner}/{repo}/branches/{branch}/protection", func(h http.Header, r *http.Request) (int, any) {
+		repo, p, ok := s.lookupProtection(r)
+		if repo == nil {
+			return notFound()
+		}
+		if !ok {
+			return branchNotProtected()
+		}
+
+		return http.StatusOK, s.protectionJSON(repo, p)
+	})
+
+	s.handle("PUT /repos/{owner}/{repo}/branches/{branch}/protection", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, p, ok := s.lookupProtection(r)
+		if repo == nil {
+			return notFound()
+		}
+
+		var req github.ProtectionRequest
+		if err := decode(r, &req); err != nil {
+			return badRequest()
+		}
+
+		if status, body, ok := s.validateProtection(repo, req); !ok {
+			return status, body
+		}
+
+		if !ok {
+			p = &protection{}
+			repo.protections[r.PathValue("branch")] = p
+		}
+		p.request = req
+
+		return http.StatusOK, s.protectionJSON(repo, p)
+	})
+
+	s.handle("DELETE /repos/{owner}/{repo}/branches/{branch}/protection", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, _, ok := s.lookupProtection(r)
+		if repo == nil {
+			return notFound()
+		}
+		if !ok {
+			return branchNotProtected()
+		}
+		delete(repo.protections, r.PathValue("branch"))
+
+		return http.StatusNoContent, nil
+	})
+
+	s.handle("POST /repos/{owner}/{repo}/branches/{branch}/protection/required_signatures", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, p, ok := s.lookupProtection(r)
+		if repo == nil {
+			return notFound()
+		}
+		if !ok {
+			return branchNotProtected()
+		}
+		p.signatures = true
+
+		return http.StatusOK, &github.SignaturesProtectedBranch{Enabled: github.Ptr(true)}
+	})
+
+	s.handle("DELETE /repos/{owner}/{repo}/branches/{branch}/protection/required_signatures", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, p, ok := s.lookupProtection(r)
+		if repo == nil {
+			return notFound()
+		}
+		if !ok {
+			return branchNotProtected()
+		}
+		p.signatures = false
+
+		return http.StatusNoContent, nil
+	})
+}
@@ -0,0 +1,260 @@
+package ghfake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// externalGroup is the state of an external group provisioned by the identity provider of an enterprise with managed users.
+type externalGroup struct {
+	id        int64
+	name      string
+	updatedAt time.Time
+}
+
+// AddExternalGroup adds an external group to an organization and returns its ID, in the same way as when a group is
+// provisioned by the identity provider.
+func (s *Server) AddExternalGroup(org, name string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organization(org)
+	if !ok {
+		return 0, fmt.Errorf("organization %q doesn't exist", org)
+	}
+
+	g := &externalGroup{id: s.nextID(), name: name, updatedAt: time.Now()}
+	o.externalGroups = append(o.externalGroups, g)
+
+	return g.id, nil
+}
+
+// AddIDPGroup adds an identity provider group to an organization which can be used for team synchronization.
+func (s *Server) AddIDPGroup(org, id, name, description string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organization(org)
+	if !ok {
+		return fmt.Errorf("organization %q doesn't exist", org)
+	}
+
+	if _, ok := o.idpGroup(id); ok {
+		return fmt.Errorf("identity provider group %q already exists", id)
+	}
+
+	o.idpGroups = append(o.idpGroups, &github.IDPGroup{GroupID: github.Ptr(id), GroupName: github.Ptr(name), GroupDescription: github.Ptr(description)})
+
+	return nil
+}
+
+// externalGroup returns the external group with the ID.
+func (o *organization) externalGroup(id int64) (*externalGroup, bool) {
+	for _, g := range o.externalGroups {
+		if g.id == id {
+			return g, true
+		}
+	}
+
+	return nil, false
+}
+
+// idpGroup returns the identity provider group with the ID.
+func (o *organization) idpGroup(id string) (*github.IDPGroup, bool) {
+	for _, g := range o.idpGroups {
+		if g.GetGroupID() == id {
+			return g, true
+		}
+	}
+
+	return nil, false
+}
+
+// externalGroupJSON returns the API representation of an external group, the teams connected to the group are only included
+// when getting a single group.
+func externalGroupJSON(o *organization, g *externalGroup, teams bool) *github.ExternalGroup {
+	out := &github.ExternalGroup{
+		GroupID:   github.Ptr(g.id),
+		GroupName: github.Ptr(g.name),
+		UpdatedAt: &github.Timestamp{Time: g.updatedAt},
+	}
+
+	if teams {
+		out.Teams = []*github.ExternalGroupTeam{}
+		out.Members = []*github.ExternalGroupMember{}
+		for _, t := range o.teams {
+			if t.externalGroupID == g.id {
+				out.Teams = append(out.Teams, &github.ExternalGroupTeam{TeamID: github.Ptr(t.id), TeamName: github.Ptr(t.name)})
+			}
+		}
+	}
+
+	return out
+}
+
+// routeExternalGroups registers the external group and team synchronization endpoints. A team can either be connected to an
+// external group or mapped to identity provider groups but not both, the name and description of a mapped group are stored as
+// they were sent in the same way as the real API.
+func (s *Server) routeExternalGroups() {
+	s.handle("GET /orgs/{org}/external-groups", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		name := strings.ToLower(r.URL.Query().Get("display_name"))
+
+		groups := []*github.ExternalGroup{}
+		for _, g := range o.externalGroups {
+			if strings.Contains(strings.ToLower(g.name), name) {
+				groups = append(groups, externalGroupJSON(o, g, false))
+			}
+		}
+
+		return http.StatusOK, &github.ExternalGroupList{Groups: paginate(h, r, groups)}
+	})
+
+	s.handle("GET /orgs/{org}/external-group/{group_id}", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		id, err := strconv.ParseInt(r.PathValue("group_id"), 10, 64)
+		if err != nil {
+			return notFound()
+		}
+
+		g, ok := o.externalGroup(id)
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, externalGroupJSON(o, g, true)
+	})
+
+	s.handle("GET /orgs/{org}/teams/{team_slug}/external-groups", func(h http.Header, r *http.Request) (int, any) {
+		o, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		groups := []*github.ExternalGroup{}
+		if g, ok := o.externalGroup(t.externalGroupID); ok {
+			groups = append(groups, externalGroupJSON(o, g, false))
+		}
+
+		return http.StatusOK, &github.ExternalGroupList{Groups: groups}
+	})
+
+	s.handle("PATCH /orgs/{org}/teams/{team_slug}/external-groups", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		var eg github.ExternalGroup
+		if err := decode(r, &eg); err != nil {
+			return badRequest()
+		}
+
+		g, ok := o.externalGroup(eg.GetGroupID())
+		if !ok {
+			return validationFailed("ExternalGroup", "group_id", "invalid", "")
+		}
+
+		if len(t.idpGroups) != 0 {
+			return validationFailed("ExternalGroup", "team", "invalid", "Team is already synchronized with identity provider groups")
+		}
+
+		t.externalGroupID = g.id
+
+		return http.StatusOK, externalGroupJSON(o, g, true)
+	})
+
+	s.handle("DELETE /orgs/{org}/teams/{team_slug}/external-groups", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		_, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		t.externalGroupID = 0
+
+		return http.StatusNoContent, nil
+	})
+
+	s.handle("GET /orgs/{org}/team-sync/groups", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		query := strings.ToLower(r.URL.Query().Get("q"))
+
+		groups := []*github.IDPGroup{}
+		for _, g := range o.idpGroups {
+			if strings.HasPrefix(strings.ToLower(g.GetGroupName()), query) {
+				groups = append(groups, g)
+			}
+		}
+
+		return http.StatusOK, &github.IDPGroupList{Groups: groups}
+	})
+
+	s.handle("GET /orgs/{org}/teams/{team_slug}/team-sync/group-mappings", func(h http.Header, r *http.Request) (int, any) {
+		_, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, &github.IDPGroupList{Groups: append([]*github.IDPGroup{}, t.idpGroups...)}
+	})
+
+	s.handle("PATCH /orgs/{org}/teams/{team_slug}/team-sync/group-mappings", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		var list github.IDPGroupList
+		if err := decode(r, &list); err != nil || list.Groups == nil {
+			return badRequest()
+		}
+
+		groups := []*github.IDPGroup{}
+		for _, g := range list.Groups {
+			if _, ok := o.idpGroup(g.GetGroupID()); !ok {
+				return validationFailed("TeamSync", "group_id", "invalid", "")
+			}
+			if !slices.ContainsFunc(groups, func(m *github.IDPGroup) bool { return m.GetGroupID() == g.GetGroupID() }) {
+				groups = append(groups, g)
+			}
+		}
+
+		if len(groups) != 0 && t.externalGroupID != 0 {
+			return validationFailed("TeamSync", "team", "invalid", "Team is already connected to an external group")
+		}
+
+		t.idpGroups = groups
+
+		return http.StatusOK, &github.IDPGroupList{Groups: t.idpGroups}
+	})
+}
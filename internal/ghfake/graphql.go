@@ -0,0 +1,74 @@
+package ghfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// graphqlOperationRegexp matches the operation name of a GraphQL document.
+var graphqlOperationRegexp = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+
+// graphqlRequest is the body of a GraphQL API request.
+type graphqlRequest struct {
+	Query         string          `json:"query"`
+	OperationName string          `json:"operationName,omitempty"`
+	Variables     json.RawMessage `json:"variables,omitempty"`
+}
+
+// graphqlResponse is the body of a GraphQL API response.
+type graphqlResponse struct {
+	Data   any            `json:"data"`
+	Errors []graphqlError `json:"errors,omitempty"`
+}
+
+// graphqlError is an error in a GraphQL API response.
+type graphqlError struct {
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message"`
+}
+
+// graphqlFunc handles a GraphQL operation and returns the data of the response, or the errors if the operation failed.
+type graphqlFunc func(r *http.Request, variables json.RawMessage) (any, []graphqlError)
+
+// handleGraphQL registers the handler for the GraphQL operation name.
+func (s *Server) handleGraphQL(operation string, h graphqlFunc) {
+	s.graphql[operation] = h
+}
+
+// graphqlNotFound returns a "NOT_FOUND" GraphQL error with the message.
+func graphqlNotFound(format string, args ...any) []graphqlError {
+	return []graphqlError{{Type: "NOT_FOUND", Message: fmt.Sprintf(format, args...)}}
+}
+
+// routeGraphQL registers the GraphQL endpoint. The operation is identified by the operation name of the request or of the
+// document, and errors are returned with a "200 OK" status in the same way as the real API.
+func (s *Server) routeGraphQL() {
+	s.serve("POST /api/graphql", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		var req graphqlRequest
+		if err := decode(r, &req); err != nil {
+			return badRequest()
+		}
+
+		operation := req.OperationName
+		if len(operation) == 0 {
+			if m := graphqlOperationRegexp.FindStringSubmatch(req.Query); m != nil {
+				operation = m[1]
+			}
+		}
+
+		handler, ok := s.graphql[operation]
+		if !ok {
+			return http.StatusOK, graphqlResponse{Errors: []graphqlError{{Message: fmt.Sprintf("Operation %q isn't supported.", operation)}}}
+		}
+
+		data, errs := handler(r, req.Variables)
+
+		return http.StatusOK, graphqlResponse{Data: data, Errors: errs}
+	})
+}
@@ -0,0 +1,372 @@
+package ghfake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// invitation is the state of a pending organization invitation, either for an existing user or for an email address.
+type invitation struct {
+	id        int64
+	login     string
+	email     string
+	role      string
+	invitedAt time.Time
+}
+
+// AcceptOrganizationInvitation accepts the pending organization invitation with the ID as the user, which is how an email
+// invitation becomes a membership.
+func (s *Server) AcceptOrganizationInvitation(org string, id int64, login string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organization(org)
+	if !ok {
+		return fmt.Errorf("organization %q doesn't exist", org)
+	}
+
+	inv, ok := o.invitationByID(id)
+	if !ok {
+		return fmt.Errorf("invitation %d doesn't exist", id)
+	}
+
+	if _, ok := s.user(login); !ok {
+		return fmt.Errorf("user %q doesn't exist", login)
+	}
+
+	o.invitations = slices.DeleteFunc(o.invitations, func(i *invitation) bool { return i == inv })
+	s.joinOrganization(o, login, membershipRole(inv.role))
+
+	return nil
+}
+
+// joinOrganization makes the user a member of the organization with the role, any pending invitations for the user are removed
+// and pending team memberships become active.
+func (s *Server) joinOrganization(o *organization, login, role string) {
+	key := strings.ToLower(login)
+
+	o.members[key] = role
+	delete(o.outsideCollaborators, key)
+	o.invitations = slices.DeleteFunc(o.invitations, func(i *invitation) bool { return strings.EqualFold(i.login, login) })
+
+	for _, t := range o.teams {
+		if m, ok := t.memberships[key]; ok {
+			m.state = "active"
+		}
+	}
+}
+
+// leaveOrganization removes the user from the organization and its teams.
+func (s *Server) leaveOrganization(o *organization, login string) {
+	key := strings.ToLower(login)
+
+	delete(o.members, key)
+	for _, t := range o.teams {
+		delete(t.memberships, key)
+	}
+}
+
+// invitationByID returns the pending invitation with the ID.
+func (o *organization) invitationByID(id int64) (*invitation, bool) {
+	for _, i := range o.invitations {
+		if i.id == id {
+			return i, true
+		}
+	}
+
+	return nil, false
+}
+
+// invitationByLogin returns the pending invitation for the user.
+func (o *organization) invitationByLogin(login string) (*invitation, bool) {
+	for _, i := range o.invitations {
+		if len(i.login) != 0 && strings.EqualFold(i.login, login) {
+			return i, true
+		}
+	}
+
+	return nil, false
+}
+
+// membershipRole returns the membership role for an invitation role, the invitations API uses "direct_member" for members.
+func membershipRole(role string) string {
+	if role == "direct_member" {
+		return "member"
+	}
+	return role
+}
+
+// invitationJSON returns the API representation of an organization invitation.
+func (s *Server) invitationJSON(inv *invitation) *github.Invitation {
+	viewer, _ := s.user(s.viewer)
+
+	out := &github.Invitation{
+		ID:        github.Ptr(inv.id),
+		Role:      github.Ptr(inv.role),
+		CreatedAt: &github.Timestamp{Time: inv.invitedAt},
+		Inviter:   simpleUser(viewer),
+		TeamCount: github.Ptr(0),
+	}
+	if len(inv.login) != 0 {
+		out.Login = github.Ptr(inv.login)
+	}
+	if len(inv.email) != 0 {
+		out.Email = github.Ptr(inv.email)
+	}
+
+	return out
+}
+
+// orgMembershipJSON returns the API representation of an organization membership.
+func orgMembershipJSON(o *organization, u *github.User, role, state string) *github.Membership {
+	return &github.Membership{
+		Role:         github.Ptr(role),
+		State:        github.Ptr(state),
+		Organization: simpleOrganization(o),
+		User:         simpleUser(u),
+	}
+}
+
+// routeMemberships registers the organization membership, invitation and outside collaborator endpoints.
+func (s *Server) routeMemberships() {
+	s.handle("GET /orgs/{org}/memberships/{username}", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		u, ok := s.user(r.PathValue("username"))
+		if !ok {
+			return notFound()
+		}
+
+		if role, ok := o.members[strings.ToLower(u.GetLogin())]; ok {
+			return http.StatusOK, orgMembershipJSON(o, u, role, "active")
+		}
+
+		if inv, ok := o.invitationByLogin(u.GetLogin()); ok {
+			return http.StatusOK, orgMembershipJSON(o, u, membershipRole(inv.role), "pending")
+		}
+
+		return notFound()
+	})
+
+	s.handle("PUT /orgs/{org}/memberships/{username}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		u, ok := s.user(r.PathValue("username"))
+		if !ok {
+			return notFound()
+		}
+
+		var m github.Membership
+		if r.ContentLength != 0 {
+			if err := decode(r, &m); err != nil {
+				return badRequest()
+			}
+		}
+
+		role := m.GetRole()
+		switch role {
+		case "":
+			role = "member"
+		case "admin", "member":
+		default:
+			return validationFailed("Membership", "role", "invalid", "")
+		}
+
+		key := strings.ToLower(u.GetLogin())
+		if _, ok := o.members[key]; ok {
+			o.members[key] = role
+			return http.StatusOK, orgMembershipJSON(o, u, role, "active")
+		}
+
+		inviteRole := role
+		if role == "member" {
+			inviteRole = "direct_member"
+		}
+
+		if inv, ok := o.invitationByLogin(u.GetLogin()); ok {
+			inv.role = inviteRole
+		} else {
+			o.invitations = append(o.invitations, &invitation{id: s.nextID(), login: u.GetLogin(), role: inviteRole, invitedAt: time.Now()})
+		}
+
+		return http.StatusOK, orgMembershipJSON(o, u, role, "pending")
+	})
+
+	s.handle("DELETE /orgs/{org}/memberships/{username}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		login := r.PathValue("username")
+		if _, ok := o.members[strings.ToLower(login)]; ok {
+			s.leaveOrganization(o, login)
+			return http.StatusNoContent, nil
+		}
+
+		if inv, ok := o.invitationByLogin(login); ok {
+			o.invitations = slices.DeleteFunc(o.invitations, func(i *invitation) bool { return i == inv })
+			return http.StatusNoContent, nil
+		}
+
+		return notFound()
+	})
+
+	s.handle("GET /orgs/{org}/invitations", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		invitations := []*github.Invitation{}
+		for _, inv := range o.invitations {
+			invitations = append(invitations, s.invitationJSON(inv))
+		}
+
+		return http.StatusOK, paginate(h, r, invitations)
+	})
+
+	s.handle("POST /orgs/{org}/invitations", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		var opts github.CreateOrgInvitationOptions
+		if err := decode(r, &opts); err != nil {
+			return badRequest()
+		}
+
+		inv := &invitation{id: s.nextID(), email: opts.GetEmail(), role: opts.GetRole(), invitedAt: time.Now()}
+		switch inv.role {
+		case "":
+			inv.role = "direct_member"
+		case "admin", "direct_member", "billing_manager":
+		default:
+			return validationFailed("OrganizationInvitation", "role", "invalid", "")
+		}
+
+		switch {
+		case opts.InviteeID != nil && opts.Email == nil:
+			var invitee *github.User
+			for _, u := range s.users {
+				if u.GetID() == opts.GetInviteeID() {
+					invitee = u
+				}
+			}
+			if invitee == nil {
+				return validationFailed("OrganizationInvitation", "invitee_id", "invalid", "")
+			}
+			if _, ok := o.members[strings.ToLower(invitee.GetLogin())]; ok {
+				return validationFailed("OrganizationInvitation", "invitee_id", "invalid", "Invitee is already a part of this organization")
+			}
+			inv.login = invitee.GetLogin()
+		case opts.Email != nil && opts.InviteeID == nil:
+			if !strings.Contains(opts.GetEmail(), "@") {
+				return validationFailed("OrganizationInvitation", "email", "invalid", "")
+			}
+		default:
+			return validationFailed("OrganizationInvitation", "invitee_id", "invalid", "Exactly one of invitee_id or email must be set")
+		}
+
+		o.invitations = append(o.invitations, inv)
+
+		return http.StatusCreated, s.invitationJSON(inv)
+	})
+
+	s.handle("DELETE /orgs/{org}/invitations/{invitation_id}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		id, err := strconv.ParseInt(r.PathValue("invitation_id"), 10, 64)
+		if err != nil {
+			return notFound()
+		}
+
+		inv, ok := o.invitationByID(id)
+		if !ok {
+			return notFound()
+		}
+		o.invitations = slices.DeleteFunc(o.invitations, func(i *invitation) bool { return i == inv })
+
+		return http.StatusNoContent, nil
+	})
+
+	// Invitations don't expire in the fake so there are never any failed invitations.
+	s.handle("GET /orgs/{org}/failed_invitations", func(h http.Header, r *http.Request) (int, any) {
+		if _, ok := s.organization(r.PathValue("org")); !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, []*github.Invitation{}
+	})
+
+	s.handle("GET /orgs/{org}/outside_collaborators", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		users := []*github.User{}
+		for login := range o.outsideCollaborators {
+			u, _ := s.user(login)
+			users = append(users, simpleUser(u))
+		}
+		slices.SortFunc(users, func(a, b *github.User) int {
+			return strings.Compare(strings.ToLower(a.GetLogin()), strings.ToLower(b.GetLogin()))
+		})
+
+		return http.StatusOK, paginate(h, r, users)
+	})
+
+	s.handle("PUT /orgs/{org}/outside_collaborators/{username}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		login := r.PathValue("username")
+		if _, ok := o.members[strings.ToLower(login)]; !ok {
+			return notFound()
+		}
+
+		s.leaveOrganization(o, login)
+		o.outsideCollaborators[strings.ToLower(login)] = true
+
+		return http.StatusNoContent, nil
+	})
+}
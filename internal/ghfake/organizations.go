@@ -0,0 +1,125 @@
+package ghfake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// organization is the state of an organization.
+type organization struct {
+	org                  *github.Organization
+	members              map[string]string
+	teams                []*team
+	properties           []*github.CustomProperty
+	rulesets             []*ruleset
+	roles                map[string]string
+	invitations          []*invitation
+	outsideCollaborators map[string]bool
+	externalGroups       []*externalGroup
+	idpGroups            []*github.IDPGroup
+}
+
+// AddOrganization adds an organization to the server and returns it, an ID is generated if the organization doesn't have
+// one. The viewer is added to the organization as an admin.
+func (s *Server) AddOrganization(o github.Organization) github.Organization {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if o.ID == nil {
+		o.ID = github.Ptr(s.nextID())
+	} else {
+		s.lastID = max(s.lastID, o.GetID())
+	}
+	o.Type = github.Ptr("Organization")
+
+	s.orgs[strings.ToLower(o.GetLogin())] = &organization{
+		org:                  &o,
+		members:              map[string]string{strings.ToLower(s.viewer): "admin"},
+		roles:                map[string]string{},
+		outsideCollaborators: map[string]bool{},
+	}
+
+	return o
+}
+
+// AddOrganizationMember adds an existing user to an organization with the role, which is either "admin" or "member".
+func (s *Server) AddOrganizationMember(org, login, role string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organization(org)
+	if !ok {
+		return fmt.Errorf("organization %q doesn't exist", org)
+	}
+
+	if _, ok := s.user(login); !ok {
+		return fmt.Errorf("user %q doesn't exist", login)
+	}
+
+	if role != "admin" && role != "member" {
+		return fmt.Errorf("invalid organization role %q", role)
+	}
+
+	s.joinOrganization(o, login, role)
+
+	return nil
+}
+
+// AddCustomRepositoryRole adds a custom repository role to an organization, the base role is one of "read", "triage", "write"
+// or "maintain".
+func (s *Server) AddCustomRepositoryRole(org, name, baseRole string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organization(org)
+	if !ok {
+		return fmt.Errorf("organization %q doesn't exist", org)
+	}
+
+	switch baseRole {
+	case "read", "triage", "write", "maintain":
+	default:
+		return fmt.Errorf("invalid base role %q", baseRole)
+	}
+
+	o.roles[name] = baseRole
+
+	return nil
+}
+
+// organization returns the organization with the login.
+func (s *Server) organization(login string) (*organization, bool) {
+	o, ok := s.orgs[strings.ToLower(login)]
+	return o, ok
+}
+
+// organizationByID returns the organization with the ID.
+func (s *Server) organizationByID(id string) (*organization, bool) {
+	for _, o := range s.orgs {
+		if fmt.Sprint(o.org.GetID()) == id {
+			return o, true
+		}
+	}
+
+	return nil, false
+}
+
+// simpleOrganization returns the short representation of an organization that is embedded in other responses.
+func simpleOrganization(o *organization) *github.Organization {
+	return &github.Organization{ID: o.org.ID, Login: o.org.Login}
+}
+
+// routeOrganizations registers the organization endpoints.
+func (s *Server) routeOrganizations() {
+	s.handle("GET /orgs/{org}", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, o.org
+	})
+}
@@ -0,0 +1,215 @@
+package ghfake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// property returns the custom property with the name.
+func (o *organization) property(name string) (*github.CustomProperty, bool) {
+	for _, p := range o.properties {
+		if p.GetPropertyName() == name {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// setProperty validates and creates or updates a custom property, if the property is invalid the error response is returned.
+func (o *organization) setProperty(p github.CustomProperty) (*github.CustomProperty, int, any, bool) {
+	if len(p.GetPropertyName()) == 0 {
+		status, body := validationFailed("CustomProperty", "property_name", "missing_field", "")
+		return nil, status, body, false
+	}
+
+	switch p.ValueType {
+	case "string", "true_false":
+		if len(p.AllowedValues) != 0 {
+			status, body := validationFailed("CustomProperty", "allowed_values", "invalid", "Allowed values are only supported for select properties")
+			return nil, status, body, false
+		}
+	case "single_select", "multi_select":
+		if len(p.AllowedValues) == 0 {
+			status, body := validationFailed("CustomProperty", "allowed_values", "missing_field", "Allowed values are required for select properties")
+			return nil, status, body, false
+		}
+	default:
+		status, body := validationFailed("CustomProperty", "value_type", "invalid", fmt.Sprintf("Invalid value type %q", p.ValueType))
+		return nil, status, body, false
+	}
+
+	if p.GetRequired() && p.DefaultValue == nil {
+		status, body := validationFailed("CustomProperty", "default_value", "missing_field", "Default value must be present for required properties")
+		return nil, status, body, false
+	}
+
+	if p.DefaultValue != nil && !validPropertyValue(&p, p.GetDefaultValue()) {
+		status, body := validationFailed("CustomProperty", "default_value", "invalid", "Default value must be an allowed value")
+		return nil, status, body, false
+	}
+
+	switch p.GetValuesEditableBy() {
+	case "":
+		p.ValuesEditableBy = github.Ptr("org_actors")
+	case "org_actors", "org_and_repo_actors":
+	default:
+		status, body := validationFailed("CustomProperty", "values_editable_by", "invalid", "")
+		return nil, status, body, false
+	}
+
+	p.Required = github.Ptr(p.GetRequired())
+	p.SourceType = github.Ptr("organization")
+
+	if existing, ok := o.property(p.GetPropertyName()); ok {
+		*existing = p
+		return existing, 0, nil, true
+	}
+
+	o.properties = append(o.properties, &p)
+
+	return &p, 0, nil, true
+}
+
+// validPropertyValue returns true if the value is valid for the property.
+func validPropertyValue(p *github.CustomProperty, value any) bool {
+	switch p.ValueType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "true_false":
+		v, ok := value.(string)
+		return ok && (v == "true" || v == "false")
+	case "single_select":
+		v, ok := value.(string)
+		return ok && slices.Contains(p.AllowedValues, v)
+	case "multi_select":
+		switch v := value.(type) {
+		case string:
+			return slices.Contains(p.AllowedValues, v)
+		case []string:
+			for _, e := range v {
+				if !slices.Contains(p.AllowedValues, e) {
+					return false
+				}
+			}
+			return true
+		case []any:
+			for _, e := range v {
+				if s, ok := e.(string); !ok || !slices.Contains(p.AllowedValues, s) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	return false
+}
+
+// routeProperties registers the organization custom property endpoints.
+func (s *Server) routeProperties() {
+	s.handle("GET /orgs/{org}/properties/schema", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, append([]*github.CustomProperty{}, o.properties...)
+	})
+
+	s.handle("PATCH /orgs/{org}/properties/schema", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		var body struct {
+			Properties []github.CustomProperty `json:"properties"`
+		}
+		if err := decode(r, &body); err != nil {
+			return badRequest()
+		}
+
+		properties := []*github.CustomProperty{}
+		for _, p := range body.Properties {
+			updated, status, errBody, ok := o.setProperty(p)
+			if !ok {
+				return status, errBody
+			}
+			properties = append(properties, updated)
+		}
+
+		return http.StatusOK, properties
+	})
+
+	s.handle("GET /orgs/{org}/properties/schema/{custom_property_name}", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		p, ok := o.property(r.PathValue("custom_property_name"))
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, p
+	})
+
+	s.handle("PUT /orgs/{org}/properties/schema/{custom_property_name}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		var p github.CustomProperty
+		if err := decode(r, &p); err != nil {
+			return badRequest()
+		}
+		p.PropertyName = github.Ptr(r.PathValue("custom_property_name"))
+
+		updated, status, body, ok := o.setProperty(p)
+		if !ok {
+			return status, body
+		}
+
+		return http.StatusOK, updated
+	})
+
+	s.handle("DELETE /orgs/{org}/properties/schema/{custom_property_name}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		name := r.PathValue("custom_property_name")
+		if _, ok := o.property(name); !ok {
+			return notFound()
+		}
+
+		o.properties = slices.DeleteFunc(o.properties, func(p *github.CustomProperty) bool { return p.GetPropertyName() == name })
+		for _, repo := range s.repos {
+			if repo.repo.GetOwner().GetID() == o.org.GetID() {
+				delete(repo.properties, name)
+			}
+		}
+
+		return http.StatusNoContent, nil
+	})
+}
@@ -0,0 +1,313 @@
+package ghfake
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// repository is the state of a repository.
+type repository struct {
+	repo        *github.Repository
+	properties  map[string]any
+	rulesets    []*ruleset
+	protections map[string]*protection
+}
+
+// AddRepository adds a repository to an organization and returns it, defaults are set in the same way as when the repository
+// is created through the API.
+func (s *Server) AddRepository(org string, r github.Repository) (github.Repository, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organization(org)
+	if !ok {
+		return github.Repository{}, fmt.Errorf("organization %q doesn't exist", org)
+	}
+
+	repo, status, body := s.createRepository(o, r)
+	if repo == nil {
+		return github.Repository{}, fmt.Errorf("failed to create repository (%d): %v", status, body)
+	}
+
+	return *repo.repo, nil
+}
+
+// repositoryKey returns the key of a repository.
+func repositoryKey(owner, name string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", owner, name))
+}
+
+// lookupRepository returns the repository for a request.
+func (s *Server) lookupRepository(r *http.Request) (*repository, bool) {
+	repo, ok := s.repos[repositoryKey(r.PathValue("owner"), r.PathValue("repo"))]
+	return repo, ok
+}
+
+// createRepository creates a repository in the organization, if the repository can't be created the error response is
+// returned instead.
+func (s *Server) createRepository(o *organization, r github.Repository) (*repository, int, any) {
+	if len(r.GetName()) == 0 {
+		status, body := validationFailed("Repository", "name", "missing_field", "")
+		return nil, status, body
+	}
+
+	key := repositoryKey(o.org.GetLogin(), r.GetName())
+	if _, ok := s.repos[key]; ok {
+		status, body := validationFailed("Repository", "name", "custom", "name already exists on this account")
+		return nil, status, body
+	}
+
+	repo := &github.Repository{
+		ID:                  github.Ptr(s.nextID()),
+		Name:                r.Name,
+		FullName:            github.Ptr(fmt.Sprintf("%s/%s", o.org.GetLogin(), r.GetName())),
+		Owner:               &github.User{ID: o.org.ID, Login: o.org.Login, Type: github.Ptr("Organization")},
+		HTMLURL:             github.Ptr(fmt.Sprintf("https://github.com/%s/%s", o.org.GetLogin(), r.GetName())),
+		Description:         github.Ptr(""),
+		Homepage:            github.Ptr(""),
+		DefaultBranch:       github.Ptr("main"),
+		Visibility:          github.Ptr("public"),
+		Private:             github.Ptr(false),
+		Archived:            github.Ptr(false),
+		AllowAutoMerge:      github.Ptr(false),
+		AllowMergeCommit:    github.Ptr(true),
+		AllowRebaseMerge:    github.Ptr(true),
+		AllowSquashMerge:    github.Ptr(true),
+		DeleteBranchOnMerge: github.Ptr(false),
+		Topics:              []string{},
+	}
+
+	if status, body, ok := patchRepository(repo, r); !ok {
+		return nil, status, body
+	}
+
+	created := &repository{repo: repo, properties: map[string]any{}, protections: map[string]*protection{}}
+	s.repos[key] = created
+
+	return created, http.StatusCreated, nil
+}
+
+// patchRepository applies the fields set in the patch to the repository.
+func patchRepository(repo *github.Repository, patch github.Repository) (int, any, bool) {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		status, body := badRequest()
+		return status, body, false
+	}
+
+	if err := json.Unmarshal(data, repo); err != nil {
+		status, body := badRequest()
+		return status, body, false
+	}
+
+	switch {
+	case patch.Visibility != nil:
+		switch patch.GetVisibility() {
+		case "public", "private", "internal":
+		default:
+			status, body := validationFailed("Repository", "visibility", "invalid", "")
+			return status, body, false
+		}
+		repo.Private = github.Ptr(patch.GetVisibility() != "public")
+	case patch.Private != nil:
+		repo.Visibility = github.Ptr("public")
+		if patch.GetPrivate() {
+			repo.Visibility = github.Ptr("private")
+		}
+	}
+
+	return 0, nil, true
+}
+
+// routeRepositories registers the repository endpoints.
+func (s *Server) routeRepositories() {
+	s.handle("GET /orgs/{org}/repos", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		repos := []*github.Repository{}
+		for _, repo := range s.repos {
+			if repo.repo.GetOwner().GetID() == o.org.GetID() {
+				repos = append(repos, repo.repo)
+			}
+		}
+		slices.SortFunc(repos, func(a, b *github.Repository) int { return cmp.Compare(a.GetID(), b.GetID()) })
+
+		return http.StatusOK, paginate(h, r, repos)
+	})
+
+	s.handle("POST /orgs/{org}/repos", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		var repo github.Repository
+		if err := decode(r, &repo); err != nil {
+			return badRequest()
+		}
+
+		created, status, body := s.createRepository(o, repo)
+		if created == nil {
+			return status, body
+		}
+
+		return http.StatusCreated, created.repo
+	})
+
+	s.handle("GET /repos/{owner}/{repo}", func(h http.Header, r *http.Request) (int, any) {
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, repo.repo
+	})
+
+	s.handle("PATCH /repos/{owner}/{repo}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		var patch github.Repository
+		if err := decode(r, &patch); err != nil {
+			return badRequest()
+		}
+
+		if repo.repo.GetArchived() && !(patch.Archived != nil && !patch.GetArchived()) {
+			return http.StatusForbidden, apiError("Repository was archived so is read-only.")
+		}
+
+		if patch.Name != nil && !strings.EqualFold(patch.GetName(), repo.repo.GetName()) {
+			if _, ok := s.repos[repositoryKey(r.PathValue("owner"), patch.GetName())]; ok {
+				return validationFailed("Repository", "name", "custom", "name already exists on this account")
+			}
+		}
+
+		updated := *repo.repo
+		if status, body, ok := patchRepository(&updated, patch); !ok {
+			return status, body
+		}
+		updated.FullName = github.Ptr(fmt.Sprintf("%s/%s", updated.GetOwner().GetLogin(), updated.GetName()))
+		updated.HTMLURL = github.Ptr(fmt.Sprintf("https://github.com/%s", updated.GetFullName()))
+
+		delete(s.repos, repositoryKey(r.PathValue("owner"), r.PathValue("repo")))
+		repo.repo = &updated
+		s.repos[repositoryKey(updated.GetOwner().GetLogin(), updated.GetName())] = repo
+
+		return http.StatusOK, repo.repo
+	})
+
+	s.handle("DELETE /repos/{owner}/{repo}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		if _, ok := s.lookupRepository(r); !ok {
+			return notFound()
+		}
+		delete(s.repos, repositoryKey(r.PathValue("owner"), r.PathValue("repo")))
+
+		return http.StatusNoContent, nil
+	})
+
+	s.handle("PUT /repos/{owner}/{repo}/topics", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		var body struct {
+			Names []string `json:"names"`
+		}
+		if err := decode(r, &body); err != nil {
+			return badRequest()
+		}
+
+		repo.repo.Topics = append([]string{}, body.Names...)
+
+		return http.StatusOK, map[string][]string{"names": repo.repo.Topics}
+	})
+
+	s.handle("GET /repos/{owner}/{repo}/properties/values", func(h http.Header, r *http.Request) (int, any) {
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		o, _ := s.organization(repo.repo.GetOwner().GetLogin())
+
+		values := []*github.CustomPropertyValue{}
+		for _, p := range o.properties {
+			if v, ok := repo.properties[p.GetPropertyName()]; ok {
+				values = append(values, &github.CustomPropertyValue{PropertyName: p.GetPropertyName(), Value: v})
+			} else if p.DefaultValue != nil {
+				values = append(values, &github.CustomPropertyValue{PropertyName: p.GetPropertyName(), Value: p.GetDefaultValue()})
+			}
+		}
+
+		return http.StatusOK, values
+	})
+
+	s.handle("PATCH /repos/{owner}/{repo}/properties/values", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		o, _ := s.organization(repo.repo.GetOwner().GetLogin())
+
+		var body struct {
+			Properties []github.CustomPropertyValue `json:"properties"`
+		}
+		if err := decode(r, &body); err != nil {
+			return badRequest()
+		}
+
+		for _, v := range body.Properties {
+			p, ok := o.property(v.PropertyName)
+			if !ok {
+				return validationFailed("CustomPropertyValue", "property_name", "invalid", fmt.Sprintf("Property %s doesn't exist", v.PropertyName))
+			}
+
+			if v.Value != nil && !validPropertyValue(p, v.Value) {
+				return validationFailed("CustomPropertyValue", "value", "invalid", fmt.Sprintf("Property %s has an invalid value", v.PropertyName))
+			}
+		}
+
+		for _, v := range body.Properties {
+			if v.Value == nil {
+				delete(repo.properties, v.PropertyName)
+			} else {
+				repo.properties[v.PropertyName] = v.Value
+			}
+		}
+
+		return http.StatusNoContent, nil
+	})
+}
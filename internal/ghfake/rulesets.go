@@ -0,0 +1,294 @@
+package ghfake
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ruleset is the state of a ruleset, the fields are stored as JSON so that every rule type round trips unchanged.
+type ruleset struct {
+	id     int64
+	fields map[string]json.RawMessage
+}
+
+// rulesetByID returns the ruleset for the "ruleset_id" path value.
+func rulesetByID(rulesets []*ruleset, r *http.Request) (*ruleset, int, bool) {
+	id, err := strconv.ParseInt(r.PathValue("ruleset_id"), 10, 64)
+	if err != nil {
+		return nil, 0, false
+	}
+
+	for i, rs := range rulesets {
+		if rs.id == id {
+			return rs, i, true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// setRulesetFields validates the fields and sets them on the ruleset, the read-only fields are ignored.
+func setRulesetFields(rs *ruleset, fields map[string]json.RawMessage) (int, any, bool) {
+	for k, v := range fields {
+		switch k {
+		case "id", "source", "source_type", "node_id", "_links", "created_at", "updated_at", "current_user_can_bypass":
+			continue
+		}
+		rs.fields[k] = v
+	}
+
+	var name string
+	if err := json.Unmarshal(rs.fields["name"], &name); err != nil || len(name) == 0 {
+		status, body := validationFailed("Ruleset", "name", "missing_field", "")
+		return status, body, false
+	}
+
+	var enforcement string
+	_ = json.Unmarshal(rs.fields["enforcement"], &enforcement)
+	switch enforcement {
+	case "active", "disabled", "evaluate":
+	default:
+		status, body := validationFailed("Ruleset", "enforcement", "invalid", "")
+		return status, body, false
+	}
+
+	if _, ok := rs.fields["target"]; !ok {
+		rs.fields["target"] = json.RawMessage(`"branch"`)
+	}
+
+	updated, _ := json.Marshal(time.Now().UTC())
+	rs.fields["updated_at"] = updated
+
+	return 0, nil, true
+}
+
+// rulesetJSON returns the API representation of a ruleset, the source is the organization login or repository full name.
+func rulesetJSON(source, sourceType string, rs *ruleset) map[string]json.RawMessage {
+	out := make(map[string]json.RawMessage, len(rs.fields)+3)
+	for k, v := range rs.fields {
+		out[k] = v
+	}
+
+	out["id"], _ = json.Marshal(rs.id)
+	out["source"], _ = json.Marshal(source)
+	out["source_type"], _ = json.Marshal(sourceType)
+
+	return out
+}
+
+// updateRuleset applies the fields of an update request to the ruleset, the ruleset is unchanged if the fields are invalid.
+func updateRuleset(rs *ruleset, fields map[string]json.RawMessage) (int, any, bool) {
+	updated := &ruleset{id: rs.id, fields: make(map[string]json.RawMessage, len(rs.fields))}
+	for k, v := range rs.fields {
+		updated.fields[k] = v
+	}
+	if status, body, ok := setRulesetFields(updated, fields); !ok {
+		return status, body, false
+	}
+	rs.fields = updated.fields
+
+	return 0, nil, true
+}
+
+// routeRulesets registers the organization and repository ruleset endpoints.
+func (s *Server) routeRulesets() {
+	s.handle("GET /orgs/{org}/rulesets", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		rulesets := make([]map[string]json.RawMessage, 0, len(o.rulesets))
+		for _, rs := range o.rulesets {
+			rulesets = append(rulesets, rulesetJSON(o.org.GetLogin(), "Organization", rs))
+		}
+
+		return http.StatusOK, paginate(h, r, rulesets)
+	})
+
+	s.handle("POST /orgs/{org}/rulesets", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		var fields map[string]json.RawMessage
+		if err := decode(r, &fields); err != nil {
+			return badRequest()
+		}
+
+		created, _ := json.Marshal(time.Now().UTC())
+		rs := &ruleset{id: s.nextID(), fields: map[string]json.RawMessage{"created_at": created}}
+		if status, body, ok := setRulesetFields(rs, fields); !ok {
+			return status, body
+		}
+		o.rulesets = append(o.rulesets, rs)
+
+		return http.StatusCreated, rulesetJSON(o.org.GetLogin(), "Organization", rs)
+	})
+
+	s.handle("GET /orgs/{org}/rulesets/{ruleset_id}", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		rs, _, ok := rulesetByID(o.rulesets, r)
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, rulesetJSON(o.org.GetLogin(), "Organization", rs)
+	})
+
+	s.handle("PUT /orgs/{org}/rulesets/{ruleset_id}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		rs, _, ok := rulesetByID(o.rulesets, r)
+		if !ok {
+			return notFound()
+		}
+
+		var fields map[string]json.RawMessage
+		if err := decode(r, &fields); err != nil {
+			return badRequest()
+		}
+
+		if status, body, ok := updateRuleset(rs, fields); !ok {
+			return status, body
+		}
+
+		return http.StatusOK, rulesetJSON(o.org.GetLogin(), "Organization", rs)
+	})
+
+	s.handle("DELETE /orgs/{org}/rulesets/{ruleset_id}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		_, i, ok := rulesetByID(o.rulesets, r)
+		if !ok {
+			return notFound()
+		}
+		o.rulesets = append(o.rulesets[:i], o.rulesets[i+1:]...)
+
+		return http.StatusNoContent, nil
+	})
+
+	s.handle("GET /repos/{owner}/{repo}/rulesets", func(h http.Header, r *http.Request) (int, any) {
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		rulesets := make([]map[string]json.RawMessage, 0, len(repo.rulesets))
+		for _, rs := range repo.rulesets {
+			rulesets = append(rulesets, rulesetJSON(repo.repo.GetFullName(), "Repository", rs))
+		}
+
+		return http.StatusOK, paginate(h, r, rulesets)
+	})
+
+	s.handle("POST /repos/{owner}/{repo}/rulesets", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		var fields map[string]json.RawMessage
+		if err := decode(r, &fields); err != nil {
+			return badRequest()
+		}
+
+		created, _ := json.Marshal(time.Now().UTC())
+		rs := &ruleset{id: s.nextID(), fields: map[string]json.RawMessage{"created_at": created}}
+		if status, body, ok := setRulesetFields(rs, fields); !ok {
+			return status, body
+		}
+		repo.rulesets = append(repo.rulesets, rs)
+
+		return http.StatusCreated, rulesetJSON(repo.repo.GetFullName(), "Repository", rs)
+	})
+
+	s.handle("GET /repos/{owner}/{repo}/rulesets/{ruleset_id}", func(h http.Header, r *http.Request) (int, any) {
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		rs, _, ok := rulesetByID(repo.rulesets, r)
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, rulesetJSON(repo.repo.GetFullName(), "Repository", rs)
+	})
+
+	s.handle("PUT /repos/{owner}/{repo}/rulesets/{ruleset_id}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		rs, _, ok := rulesetByID(repo.rulesets, r)
+		if !ok {
+			return notFound()
+		}
+
+		var fields map[string]json.RawMessage
+		if err := decode(r, &fields); err != nil {
+			return badRequest()
+		}
+
+		if status, body, ok := updateRuleset(rs, fields); !ok {
+			return status, body
+		}
+
+		return http.StatusOK, rulesetJSON(repo.repo.GetFullName(), "Repository", rs)
+	})
+
+	s.handle("DELETE /repos/{owner}/{repo}/rulesets/{ruleset_id}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		repo, ok := s.lookupRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		_, i, ok := rulesetByID(repo.rulesets, r)
+		if !ok {
+			return notFound()
+		}
+		repo.rulesets = append(repo.rulesets[:i], repo.rulesets[i+1:]...)
+
+		return http.StatusNoContent, nil
+	})
+}
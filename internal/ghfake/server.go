@@ -0,0 +1,276 @@
+// Package ghfake provides an in-process fake of the GitHub REST API so that the provider can be tested without a GitHub
+// account. The fake supports users, organizations, organization memberships and invitations, teams, team memberships, team
+// repository access, external groups and team synchronization, custom properties, rulesets, repositories and branch
+// protections, and mimics the pagination, ETag and rate limit behavior of the real API. The GraphQL API is limited to the
+// operations used by the provider, which are matched by their operation name.
+package ghfake
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+const (
+	// RateLimit is the number of requests allowed in each rate limit window.
+	RateLimit = 5000
+
+	// RateLimitWindow is the duration of a rate limit window.
+	RateLimitWindow = time.Hour
+
+	apiPrefix        = "/api/v3"
+	defaultPerPage   = 30
+	maxPerPage       = 100
+	documentationURL = "https://docs.github.com/rest"
+)
+
+// Server is a fake GitHub API server. The API is served under "/api/v3/" and the GraphQL API under "/api/graphql" in the same
+// way as GitHub Enterprise Server, so the server URL can be used as the base URL of a client.
+type Server struct {
+	srv     *httptest.Server
+	mux     *http.ServeMux
+	graphql map[string]graphqlFunc
+
+	mu            sync.Mutex
+	lastID        int64
+	viewer        string
+	users         map[string]*github.User
+	orgs          map[string]*organization
+	repos         map[string]*repository
+	rateRemaining int
+	rateReset     time.Time
+}
+
+// errorBody is the body of an API error response.
+type errorBody struct {
+	Message          string         `json:"message"`
+	Errors           []github.Error `json:"errors,omitempty"`
+	DocumentationURL string         `json:"documentation_url"`
+}
+
+// handlerFunc handles an API request and returns the status code and body of the response; a nil body results in an empty
+// response. Response headers, such as the pagination links, can be set on the header.
+type handlerFunc func(h http.Header, r *http.Request) (int, any)
+
+// NewServer starts a fake GitHub API server where authenticated requests are made as the viewer, which is created as a user.
+// The server should be closed by calling Close.
+func NewServer(viewer string) *Server {
+	s := &Server{
+		mux:           http.NewServeMux(),
+		graphql:       map[string]graphqlFunc{},
+		viewer:        viewer,
+		users:         map[string]*github.User{},
+		orgs:          map[string]*organization{},
+		repos:         map[string]*repository{},
+		rateRemaining: RateLimit,
+		rateReset:     time.Now().Add(RateLimitWindow),
+	}
+
+	s.AddUser(github.User{Login: github.Ptr(viewer)})
+
+	s.routeApps()
+	s.routeUsers()
+	s.routeOrganizations()
+	s.routeMemberships()
+	s.routeTeams()
+	s.routeProperties()
+	s.routeRulesets()
+	s.routeRepositories()
+	s.routeBranchProtections()
+	s.routeTeamRepositories()
+	s.routeExternalGroups()
+	s.routeGraphQL()
+
+	s.srv = httptest.NewServer(s.mux)
+
+	return s
+}
+
+// URL returns the URL of the server, this should be used as the base URL of a client.
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// SetRateLimitRemaining sets the number of requests remaining in the current rate limit window. Requests are rejected once
+// there are no requests remaining until the window is reset.
+func (s *Server) SetRateLimitRemaining(remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateRemaining = remaining
+}
+
+// handle registers the handler for the pattern, which must start with the method and is relative to the API prefix.
+func (s *Server) handle(pattern string, h handlerFunc) {
+	method, p, _ := strings.Cut(pattern, " ")
+	s.serve(fmt.Sprintf("%s %s%s", method, apiPrefix, p), h)
+}
+
+// serve registers the handler for the absolute pattern, applying the rate limit to the requests.
+func (s *Server) serve(pattern string, h handlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if now := time.Now(); now.After(s.rateReset) {
+			s.rateRemaining = RateLimit
+			s.rateReset = now.Add(RateLimitWindow)
+		}
+
+		if s.rateRemaining <= 0 {
+			s.write(w, r, http.StatusForbidden, apiError(fmt.Sprintf("API rate limit exceeded for user %s.", s.viewer)))
+			return
+		}
+
+		status, body := h(w.Header(), r)
+		s.write(w, r, status, body)
+	})
+}
+
+// write writes the response, setting the ETag and rate limit headers. GET requests with a matching If-None-Match header get
+// a "304 Not Modified" response, which doesn't count towards the rate limit.
+func (s *Server) write(w http.ResponseWriter, r *http.Request, status int, body any) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if body != nil && status == http.StatusOK && r.Method == http.MethodGet {
+		etag := fmt.Sprintf(`W/"%x"`, sha256.Sum256(data))
+		w.Header().Set("ETag", etag)
+
+		if match := r.Header.Get("If-None-Match"); len(match) != 0 && strings.TrimPrefix(match, "W/") == strings.TrimPrefix(etag, "W/") {
+			s.writeRateLimit(w.Header())
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	if s.rateRemaining > 0 {
+		s.rateRemaining--
+	}
+	s.writeRateLimit(w.Header())
+
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
+
+// writeRateLimit sets the rate limit headers.
+func (s *Server) writeRateLimit(h http.Header) {
+	h.Set("X-RateLimit-Limit", strconv.Itoa(RateLimit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(s.rateRemaining))
+	h.Set("X-RateLimit-Used", strconv.Itoa(RateLimit-s.rateRemaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(s.rateReset.Unix(), 10))
+	h.Set("X-RateLimit-Resource", "core")
+}
+
+// nextID returns a new unique ID.
+func (s *Server) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+// authenticated returns true if the request has credentials.
+func authenticated(r *http.Request) bool {
+	return len(r.Header.Get("Authorization")) != 0
+}
+
+// apiError returns an error body with the message.
+func apiError(message string) errorBody {
+	return errorBody{Message: message, DocumentationURL: documentationURL}
+}
+
+// notFound returns a "404 Not Found" response.
+func notFound() (int, any) {
+	return http.StatusNotFound, apiError("Not Found")
+}
+
+// unauthorized returns a "401 Unauthorized" response.
+func unauthorized() (int, any) {
+	return http.StatusUnauthorized, apiError("Requires authentication")
+}
+
+// badRequest returns a "400 Bad Request" response for a body which couldn't be parsed.
+func badRequest() (int, any) {
+	return http.StatusBadRequest, apiError("Problems parsing JSON")
+}
+
+// validationFailed returns a "422 Unprocessable Entity" response for the resource field.
+func validationFailed(resource, field, code, message string) (int, any) {
+	body := apiError("Validation Failed")
+	body.Errors = []github.Error{{Resource: resource, Field: field, Code: code, Message: message}}
+	return http.StatusUnprocessableEntity, body
+}
+
+// decode decodes the JSON request body into v.
+func decode(r *http.Request, v any) error {
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+// paginate returns the page of items requested by the "page" and "per_page" query parameters and sets the Link header for
+// the other pages.
+func paginate[T any](h http.Header, r *http.Request, items []T) []T {
+	query := r.URL.Query()
+
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+	perPage = min(perPage, maxPerPage)
+
+	last := max(1, (len(items)+perPage-1)/perPage)
+
+	link := func(p int, rel string) string {
+		u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+		q := r.URL.Query()
+		q.Set("page", strconv.Itoa(p))
+		q.Set("per_page", strconv.Itoa(perPage))
+		u.RawQuery = q.Encode()
+		return fmt.Sprintf(`<%s>; rel="%s"`, u.String(), rel)
+	}
+
+	var links []string
+	if page > 1 {
+		links = append(links, link(min(page-1, last), "prev"), link(1, "first"))
+	}
+	if page < last {
+		links = append(links, link(page+1, "next"), link(last, "last"))
+	}
+	if len(links) != 0 {
+		h.Set("Link", strings.Join(links, ", "))
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+
+	return append([]T{}, items[start:end]...)
+}
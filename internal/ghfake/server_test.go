@@ -0,0 +1,577 @@
+package ghfake
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+func testServer(t *testing.T) (*Server, *github.Client) {
+	t.Helper()
+
+	s := NewServer("octocat")
+	t.Cleanup(s.Close)
+
+	s.AddOrganization(github.Organization{Login: github.Ptr("test-org"), Name: github.Ptr("Test Org")})
+	s.AddUser(github.User{Login: github.Ptr("hubot")})
+	if err := s.AddOrganizationMember("test-org", "hubot", "member"); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := ghutil.NewGitHubClient(github.Ptr("test"), ghutil.ClientOptions{BaseURL: s.URL(), Retry: &ghutil.RetryOptions{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s, client
+}
+
+func TestPagination(t *testing.T) {
+	s, client := testServer(t)
+
+	for i := range 74 {
+		if _, err := s.AddTeam("test-org", github.NewTeam{Name: fmt.Sprintf("team-%02d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var slugs []string
+	opts := &github.ListOptions{PerPage: 30}
+	pages := 0
+	for {
+		teams, resp, err := client.Teams.ListTeams(t.Context(), "test-org", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages++
+
+		if resp.LastPage != 0 && resp.LastPage != 3 {
+			t.Fatalf("expected last page 3, got %d", resp.LastPage)
+		}
+
+		for _, team := range teams {
+			slugs = append(slugs, team.GetSlug())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if pages != 3 {
+		t.Fatalf("expected 3 pages, got %d", pages)
+	}
+	if len(slugs) != 74 || slugs[0] != "team-00" || slugs[73] != "team-73" {
+		t.Fatalf("unexpected teams %v", slugs)
+	}
+}
+
+func TestETag(t *testing.T) {
+	s, _ := testServer(t)
+
+	get := func(etag string) *http.Response {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, s.URL()+"/api/v3/orgs/test-org", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(etag) != 0 {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+
+		return resp
+	}
+
+	first := get("")
+	if first.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", first.StatusCode)
+	}
+
+	etag := first.Header.Get("ETag")
+	if len(etag) == 0 {
+		t.Fatal("expected an ETag header")
+	}
+
+	second := get(etag)
+	if second.StatusCode != http.StatusNotModified {
+		t.Fatalf("expected status 304, got %d", second.StatusCode)
+	}
+
+	if got, want := second.Header.Get("X-RateLimit-Remaining"), first.Header.Get("X-RateLimit-Remaining"); got != want {
+		t.Fatalf("expected a conditional request not to use the rate limit, remaining %s, want %s", got, want)
+	}
+
+	s.AddOrganization(github.Organization{Login: github.Ptr("test-org"), Name: github.Ptr("Renamed")})
+
+	if third := get(etag); third.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200 after a change, got %d", third.StatusCode)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	s, client := testServer(t)
+
+	_, resp, err := client.Organizations.Get(t.Context(), "test-org")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Rate.Limit != RateLimit {
+		t.Fatalf("expected limit %d, got %d", RateLimit, resp.Rate.Limit)
+	}
+	if resp.Rate.Remaining != RateLimit-1 {
+		t.Fatalf("expected remaining %d, got %d", RateLimit-1, resp.Rate.Remaining)
+	}
+	if used := resp.Header.Get("X-RateLimit-Used"); used != strconv.Itoa(1) {
+		t.Fatalf("expected used 1, got %s", used)
+	}
+
+	s.SetRateLimitRemaining(0)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, s.URL()+"/api/v3/orgs/test-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = raw.Body.Close()
+
+	if raw.StatusCode != http.StatusForbidden || raw.Header.Get("X-RateLimit-Remaining") != "0" {
+		t.Fatalf("expected a rate limited response, got %d with %s remaining", raw.StatusCode, raw.Header.Get("X-RateLimit-Remaining"))
+	}
+}
+
+func TestTeamMemberships(t *testing.T) {
+	s, client := testServer(t)
+	s.AddUser(github.User{Login: github.Ptr("outsider")})
+
+	team, _, err := client.Teams.CreateTeam(t.Context(), "test-org", github.NewTeam{Name: "My Team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team.GetSlug() != "my-team" || team.GetPrivacy() != "secret" {
+		t.Fatalf("unexpected team %v", team)
+	}
+
+	if _, _, err := client.Teams.CreateTeam(t.Context(), "test-org", github.NewTeam{Name: "My Team"}); err == nil {
+		t.Fatal("expected an error creating a duplicate team")
+	}
+
+	for _, login := range []string{"hubot", "outsider"} {
+		if _, _, err := client.Teams.AddTeamMembershipBySlug(t.Context(), "test-org", "my-team", login, &github.TeamAddTeamMembershipOptions{Role: "member"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m, _, err := client.Teams.GetTeamMembershipBySlug(t.Context(), "test-org", "my-team", "outsider")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.GetState() != "pending" {
+		t.Fatalf("expected a pending membership, got %q", m.GetState())
+	}
+
+	members, _, err := client.Teams.ListTeamMembersBySlug(t.Context(), "test-org", "my-team", &github.TeamListTeamMembersOptions{Role: "member"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 1 || members[0].GetLogin() != "hubot" {
+		t.Fatalf("unexpected members %v", members)
+	}
+
+	invitations, _, err := client.Teams.ListPendingTeamInvitationsBySlug(t.Context(), "test-org", "my-team", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations) != 1 || invitations[0].GetLogin() != "outsider" {
+		t.Fatalf("unexpected invitations %v", invitations)
+	}
+
+	org, _, err := client.Organizations.Get(t.Context(), "test-org")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	renamed, _, err := client.Teams.EditTeamByID(t.Context(), org.GetID(), team.GetID(), github.NewTeam{Name: "Renamed Team"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renamed.GetSlug() != "renamed-team" {
+		t.Fatalf("expected slug renamed-team, got %q", renamed.GetSlug())
+	}
+
+	if _, err := client.Teams.DeleteTeamByID(t.Context(), org.GetID(), team.GetID()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Teams.GetTeamBySlug(t.Context(), "test-org", "renamed-team"); !ghutil.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestCustomProperties(t *testing.T) {
+	s, client := testServer(t)
+
+	if _, err := s.AddRepository("test-org", github.Repository{Name: github.Ptr("test-repo")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Organizations.CreateOrUpdateCustomProperty(t.Context(), "test-org", "env", &github.CustomProperty{
+		ValueType:     "single_select",
+		AllowedValues: []string{"dev", "prod"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Repositories.CreateOrUpdateCustomProperties(t.Context(), "test-org", "test-repo", []*github.CustomPropertyValue{{PropertyName: "env", Value: "test"}}); err == nil {
+		t.Fatal("expected an error setting a value which isn't allowed")
+	}
+
+	if _, err := client.Repositories.CreateOrUpdateCustomProperties(t.Context(), "test-org", "test-repo", []*github.CustomPropertyValue{{PropertyName: "env", Value: "prod"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	values, _, err := client.Repositories.GetAllCustomPropertyValues(t.Context(), "test-org", "test-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 1 || values[0].PropertyName != "env" || values[0].Value != "prod" {
+		t.Fatalf("unexpected values %v", values)
+	}
+
+	if _, err := client.Organizations.RemoveCustomProperty(t.Context(), "test-org", "env"); err != nil {
+		t.Fatal(err)
+	}
+
+	values, _, err = client.Repositories.GetAllCustomPropertyValues(t.Context(), "test-org", "test-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 0 {
+		t.Fatalf("expected no values, got %v", values)
+	}
+}
+
+func TestBranchProtection(t *testing.T) {
+	s, client := testServer(t)
+
+	if _, err := s.AddRepository("test-org", github.Repository{Name: github.Ptr("test-repo")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Repositories.GetBranchProtection(t.Context(), "test-org", "test-repo", "main"); !errors.Is(err, github.ErrBranchNotProtected) {
+		t.Fatalf("expected the branch not to be protected, got %v", err)
+	}
+
+	if _, _, err := client.Repositories.UpdateBranchProtection(t.Context(), "test-org", "test-repo", "main", &github.ProtectionRequest{
+		Restrictions: &github.BranchRestrictionsRequest{Users: []string{"missing"}, Teams: []string{}},
+	}); err == nil {
+		t.Fatal("expected an error restricting to a user which doesn't exist")
+	}
+
+	if _, _, err := client.Repositories.UpdateBranchProtection(t.Context(), "test-org", "test-repo", "main", &github.ProtectionRequest{
+		Restrictions: &github.BranchRestrictionsRequest{Users: []string{"octocat", "hubot"}, Teams: []string{}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Repositories.RequireSignaturesOnProtectedBranch(t.Context(), "test-org", "test-repo", "main"); err != nil {
+		t.Fatal(err)
+	}
+
+	p, _, err := client.Repositories.GetBranchProtection(t.Context(), "test-org", "test-repo", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.GetRequiredSignatures().GetEnabled() {
+		t.Fatal("expected required signatures to be enabled")
+	}
+	if users := p.GetRestrictions().Users; len(users) != 2 || users[0].GetLogin() != "hubot" || users[1].GetLogin() != "octocat" {
+		t.Fatalf("expected the users to be sorted, got %v", users)
+	}
+
+	if _, err := client.Repositories.RemoveBranchProtection(t.Context(), "test-org", "test-repo", "main"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Repositories.GetBranchProtection(t.Context(), "test-org", "test-repo", "main"); !errors.Is(err, github.ErrBranchNotProtected) {
+		t.Fatalf("expected the branch not to be protected, got %v", err)
+	}
+}
+
+func TestTeamRepositories(t *testing.T) {
+	s, client := testServer(t)
+
+	if _, err := s.AddTeam("test-org", github.NewTeam{Name: "Test Team"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AddRepository("test-org", github.Repository{Name: github.Ptr("test-repo")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddCustomRepositoryRole("test-org", "security-reviewer", "triage"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Teams.IsTeamRepoBySlug(t.Context(), "test-org", "test-team", "test-org", "test-repo"); !ghutil.IsNotFound(err) {
+		t.Fatalf("expected the team not to have access, got %v", err)
+	}
+
+	if _, err := client.Teams.AddTeamRepoBySlug(t.Context(), "test-org", "test-team", "test-org", "test-repo", &github.TeamAddTeamRepoOptions{Permission: "unknown"}); err == nil {
+		t.Fatal("expected an error adding a role which doesn't exist")
+	}
+
+	for _, tc := range []struct{ permission, want string }{
+		{permission: "push", want: "write"},
+		{permission: "security-reviewer", want: "security-reviewer"},
+	} {
+		if _, err := client.Teams.AddTeamRepoBySlug(t.Context(), "test-org", "test-team", "test-org", "test-repo", &github.TeamAddTeamRepoOptions{Permission: tc.permission}); err != nil {
+			t.Fatal(err)
+		}
+
+		repo, _, err := client.Teams.IsTeamRepoBySlug(t.Context(), "test-org", "test-team", "test-org", "test-repo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if repo.GetRoleName() != tc.want {
+			t.Fatalf("expected role %q, got %q", tc.want, repo.GetRoleName())
+		}
+	}
+
+	repos, _, err := client.Teams.ListTeamReposBySlug(t.Context(), "test-org", "test-team", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 || !repos[0].GetPermissions()["triage"] || repos[0].GetPermissions()["push"] {
+		t.Fatalf("unexpected repositories %v", repos)
+	}
+
+	if _, err := client.Teams.RemoveTeamRepoBySlug(t.Context(), "test-org", "test-team", "test-org", "test-repo"); err != nil {
+		t.Fatal(err)
+	}
+
+	repos, _, err = client.Teams.ListTeamReposBySlug(t.Context(), "test-org", "test-team", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 0 {
+		t.Fatalf("expected no repositories, got %v", repos)
+	}
+}
+
+func TestOrganizationMemberships(t *testing.T) {
+	s, client := testServer(t)
+	s.AddUser(github.User{Login: github.Ptr("outsider")})
+
+	m, _, err := client.Organizations.EditOrgMembership(t.Context(), "outsider", "test-org", &github.Membership{Role: github.Ptr("admin")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.GetState() != "pending" || m.GetRole() != "admin" {
+		t.Fatalf("unexpected membership %v", m)
+	}
+
+	inv, _, err := client.Organizations.CreateOrgInvitation(t.Context(), "test-org", &github.CreateOrgInvitationOptions{Email: github.Ptr("someone@example.com")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.GetRole() != "direct_member" {
+		t.Fatalf("expected role direct_member, got %q", inv.GetRole())
+	}
+
+	invitations, _, err := client.Organizations.ListPendingOrgInvitations(t.Context(), "test-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations) != 2 || invitations[0].GetLogin() != "outsider" || invitations[1].GetEmail() != "someone@example.com" {
+		t.Fatalf("unexpected invitations %v", invitations)
+	}
+
+	if err := s.AcceptOrganizationInvitation("test-org", inv.GetID(), "outsider"); err != nil {
+		t.Fatal(err)
+	}
+
+	m, _, err = client.Organizations.GetOrgMembership(t.Context(), "outsider", "test-org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.GetState() != "active" || m.GetRole() != "member" {
+		t.Fatalf("unexpected membership %v", m)
+	}
+
+	invitations, _, err = client.Organizations.ListPendingOrgInvitations(t.Context(), "test-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations) != 0 {
+		t.Fatalf("expected no invitations, got %v", invitations)
+	}
+
+	if _, err := client.Organizations.ConvertMemberToOutsideCollaborator(t.Context(), "test-org", "outsider"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Organizations.GetOrgMembership(t.Context(), "outsider", "test-org"); !ghutil.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	collaborators, _, err := client.Organizations.ListOutsideCollaborators(t.Context(), "test-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(collaborators) != 1 || collaborators[0].GetLogin() != "outsider" {
+		t.Fatalf("unexpected outside collaborators %v", collaborators)
+	}
+
+	inv, _, err = client.Organizations.CreateOrgInvitation(t.Context(), "test-org", &github.CreateOrgInvitationOptions{Email: github.Ptr("other@example.com"), Role: github.Ptr("admin")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Organizations.CancelInvite(t.Context(), "test-org", inv.GetID()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Organizations.CancelInvite(t.Context(), "test-org", inv.GetID()); !ghutil.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestExternalGroups(t *testing.T) {
+	s, client := testServer(t)
+
+	if _, err := s.AddTeam("test-org", github.NewTeam{Name: "Test Team"}); err != nil {
+		t.Fatal(err)
+	}
+	id, err := s.AddExternalGroup("test-org", "Engineering")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddIDPGroup("test-org", "abc-123", "Engineering", "Engineering group"); err != nil {
+		t.Fatal(err)
+	}
+
+	groups, _, err := client.Teams.ListExternalGroups(t.Context(), "test-org", &github.ListExternalGroupsOptions{DisplayName: github.Ptr("eng")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups.Groups) != 1 || groups.Groups[0].GetGroupID() != id {
+		t.Fatalf("unexpected external groups %v", groups.Groups)
+	}
+
+	if _, _, err := client.Teams.UpdateConnectedExternalGroup(t.Context(), "test-org", "test-team", &github.ExternalGroup{GroupID: github.Ptr(id + 100)}); err == nil {
+		t.Fatal("expected an error connecting a group which doesn't exist")
+	}
+
+	if _, _, err := client.Teams.UpdateConnectedExternalGroup(t.Context(), "test-org", "test-team", &github.ExternalGroup{GroupID: github.Ptr(id)}); err != nil {
+		t.Fatal(err)
+	}
+
+	g, _, err := client.Teams.GetExternalGroup(t.Context(), "test-org", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Teams) != 1 || g.Teams[0].GetTeamName() != "Test Team" {
+		t.Fatalf("unexpected external group teams %v", g.Teams)
+	}
+
+	if _, _, err := client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(t.Context(), "test-org", "test-team", github.IDPGroupList{Groups: []*github.IDPGroup{{GroupID: github.Ptr("abc-123")}}}); err == nil {
+		t.Fatal("expected an error mapping identity provider groups to a team with an external group")
+	}
+
+	if _, err := client.Teams.RemoveConnectedExternalGroup(t.Context(), "test-org", "test-team"); err != nil {
+		t.Fatal(err)
+	}
+
+	groups, _, err = client.Teams.ListExternalGroupsForTeamBySlug(t.Context(), "test-org", "test-team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups.Groups) != 0 {
+		t.Fatalf("expected no external groups, got %v", groups.Groups)
+	}
+
+	mappings, _, err := client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(t.Context(), "test-org", "test-team", github.IDPGroupList{Groups: []*github.IDPGroup{{GroupID: github.Ptr("abc-123"), GroupName: github.Ptr("Engineering")}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mappings.Groups) != 1 || mappings.Groups[0].GetGroupName() != "Engineering" {
+		t.Fatalf("unexpected group mappings %v", mappings.Groups)
+	}
+
+	if _, _, err := client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(t.Context(), "test-org", "test-team", github.IDPGroupList{Groups: []*github.IDPGroup{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	mappings, _, err = client.Teams.ListIDPGroupsForTeamBySlug(t.Context(), "test-org", "test-team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mappings.Groups) != 0 {
+		t.Fatalf("expected no group mappings, got %v", mappings.Groups)
+	}
+}
+
+func TestTeamCodeReviewAssignment(t *testing.T) {
+	s, client := testServer(t)
+
+	team, err := s.AddTeam("test-org", github.NewTeam{Name: "Test Team", LDAPDN: github.Ptr("cn=test,ou=teams,dc=example,dc=com")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team.GetLDAPDN() != "cn=test,ou=teams,dc=example,dc=com" {
+		t.Fatalf("unexpected LDAP DN %q", team.GetLDAPDN())
+	}
+
+	const query = `query TeamCodeReviewAssignment($organization: String!, $slug: String!) { organization(login: $organization) { team(slug: $slug) { reviewRequestDelegationEnabled reviewRequestDelegationAlgorithm reviewRequestDelegationMemberCount } } }`
+	const mutation = `mutation UpdateTeamReviewAssignment($input: UpdateTeamReviewAssignmentInput!) { updateTeamReviewAssignment(input: $input) { team { id } } }`
+
+	var data struct {
+		Organization struct {
+			Team struct {
+				ReviewRequestDelegationEnabled     bool
+				ReviewRequestDelegationAlgorithm   string
+				ReviewRequestDelegationMemberCount int
+			}
+		}
+	}
+	if err := ghutil.GraphQL(t.Context(), client, query, map[string]any{"organization": "test-org", "slug": "test-team"}, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data.Organization.Team.ReviewRequestDelegationEnabled || data.Organization.Team.ReviewRequestDelegationAlgorithm != "ROUND_ROBIN" {
+		t.Fatalf("unexpected code review assignment %+v", data.Organization.Team)
+	}
+
+	var gqlErr *ghutil.GraphQLError
+	if err := ghutil.GraphQL(t.Context(), client, mutation, map[string]any{"input": map[string]any{"id": team.GetNodeID(), "enabled": true, "teamMemberCount": 0}}, nil); !errors.As(err, &gqlErr) {
+		t.Fatalf("expected a GraphQL error, got %v", err)
+	}
+
+	if err := ghutil.GraphQL(t.Context(), client, mutation, map[string]any{"input": map[string]any{"id": team.GetNodeID(), "enabled": true, "algorithm": "LOAD_BALANCE", "teamMemberCount": 2}}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ghutil.GraphQL(t.Context(), client, query, map[string]any{"organization": "test-org", "slug": "test-team"}, &data); err != nil {
+		t.Fatal(err)
+	}
+	if !data.Organization.Team.ReviewRequestDelegationEnabled || data.Organization.Team.ReviewRequestDelegationAlgorithm != "LOAD_BALANCE" || data.Organization.Team.ReviewRequestDelegationMemberCount != 2 {
+		t.Fatalf("unexpected code review assignment %+v", data.Organization.Team)
+	}
+
+	if err := ghutil.GraphQL(t.Context(), client, "query Unknown { viewer { login } }", nil, nil); !errors.As(err, &gqlErr) {
+		t.Fatalf("expected a GraphQL error for an unsupported operation, got %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package ghfake
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// repositoryRoles are the base repository roles in order of increasing access.
+var repositoryRoles = []string{"read", "triage", "write", "maintain", "admin"}
+
+// repositoryRoleName returns the role name for a team repository permission, the API accepts the legacy "pull" and "push"
+// permissions but returns the "read" and "write" role names. Custom roles must exist in the organization.
+func repositoryRoleName(o *organization, permission string) (string, bool) {
+	switch permission {
+	case "pull":
+		return "read", true
+	case "push":
+		return "write", true
+	case "triage", "maintain", "admin":
+		return permission, true
+	}
+
+	_, ok := o.roles[permission]
+	return permission, ok
+}
+
+// teamRepositoryJSON returns the API representation of a repository including the role and permissions of the team.
+func teamRepositoryJSON(o *organization, repo *repository, role string) *github.Repository {
+	base := role
+	if b, ok := o.roles[role]; ok {
+		base = b
+	}
+	level := slices.Index(repositoryRoles, base)
+
+	out := *repo.repo
+	out.RoleName = github.Ptr(role)
+	out.Permissions = map[string]bool{
+		"pull":     level >= 0,
+		"triage":   level >= 1,
+		"push":     level >= 2,
+		"maintain": level >= 3,
+		"admin":    level >= 4,
+	}
+
+	return &out
+}
+
+// lookupTeamRepository returns the organization, team and repository for a request, the repository must be owned by the
+// organization.
+func (s *Server) lookupTeamRepository(r *http.Request) (*organization, *team, *repository, bool) {
+	o, t, ok := s.lookupTeam(r)
+	if !ok {
+		return nil, nil, nil, false
+	}
+
+	repo, ok := s.lookupRepository(r)
+	if !ok || repo.repo.GetOwner().GetID() != o.org.GetID() {
+		return nil, nil, nil, false
+	}
+
+	return o, t, repo, true
+}
+
+// routeTeamRepositories registers the team repository endpoints.
+func (s *Server) routeTeamRepositories() {
+	s.handle("GET /orgs/{org}/teams/{team_slug}/repos", func(h http.Header, r *http.Request) (int, any) {
+		o, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		repos := []*github.Repository{}
+		for _, repo := range s.repos {
+			if role, ok := t.repositories[repo.repo.GetID()]; ok {
+				repos = append(repos, teamRepositoryJSON(o, repo, role))
+			}
+		}
+		slices.SortFunc(repos, func(a, b *github.Repository) int { return cmp.Compare(a.GetID(), b.GetID()) })
+
+		return http.StatusOK, paginate(h, r, repos)
+	})
+
+	s.handle("GET /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}", func(h http.Header, r *http.Request) (int, any) {
+		o, t, repo, ok := s.lookupTeamRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		role, ok := t.repositories[repo.repo.GetID()]
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, teamRepositoryJSON(o, repo, role)
+	})
+
+	s.handle("PUT /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, t, repo, ok := s.lookupTeamRepository(r)
+		if !ok {
+			return notFound()
+		}
+
+		var opts github.TeamAddTeamRepoOptions
+		if r.ContentLength != 0 {
+			if err := decode(r, &opts); err != nil {
+				return badRequest()
+			}
+		}
+		if len(opts.Permission) == 0 {
+			opts.Permission = t.permission
+		}
+
+		role, ok := repositoryRoleName(o, opts.Permission)
+		if !ok {
+			return validationFailed("Team", "permission", "invalid", "")
+		}
+		t.repositories[repo.repo.GetID()] = role
+
+		return http.StatusNoContent, nil
+	})
+
+	s.handle("DELETE /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		_, t, repo, ok := s.lookupTeamRepository(r)
+		if !ok {
+			return notFound()
+		}
+		delete(t.repositories, repo.repo.GetID())
+
+		return http.StatusNoContent, nil
+	})
+}
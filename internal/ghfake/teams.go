@@ -0,0 +1,662 @@
+package ghfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+// team is the state of a team.
+type team struct {
+	id                  int64
+	name                string
+	slug                string
+	description         string
+	privacy             string
+	notificationSetting string
+	permission          string
+	parentID            int64
+	memberships         map[string]*membership
+	repositories        map[int64]string
+	externalGroupID     int64
+	idpGroups           []*github.IDPGroup
+	ldapDN              string
+	reviewAssignment    reviewAssignment
+}
+
+// reviewAssignment is the code review assignment configuration of a team, which is only available through the GraphQL API.
+type reviewAssignment struct {
+	enabled     bool
+	algorithm   string
+	memberCount int
+	notifyTeam  bool
+}
+
+// membership is the state of a team membership, pending memberships are for users who have been invited to the organization.
+type membership struct {
+	login        string
+	role         string
+	state        string
+	invitationID int64
+	invitedAt    time.Time
+}
+
+// AddTeam adds a team to an organization and returns it, the viewer is added to the team as a maintainer in the same way as
+// when the team is created through the API.
+func (s *Server) AddTeam(org string, nt github.NewTeam) (github.Team, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organization(org)
+	if !ok {
+		return github.Team{}, fmt.Errorf("organization %q doesn't exist", org)
+	}
+
+	t, status, body := s.createTeam(o, nt)
+	if t == nil {
+		return github.Team{}, fmt.Errorf("failed to create team (%d): %v", status, body)
+	}
+
+	return *s.teamJSON(o, t), nil
+}
+
+// AddTeamMember adds an existing user to a team with the role, which is either "member" or "maintainer". The membership is
+// pending unless the user is a member of the organization.
+func (s *Server) AddTeamMember(org, slug, login, role string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organization(org)
+	if !ok {
+		return fmt.Errorf("organization %q doesn't exist", org)
+	}
+
+	t, ok := o.teamBySlug(slug)
+	if !ok {
+		return fmt.Errorf("team %q doesn't exist", slug)
+	}
+
+	if _, ok := s.user(login); !ok {
+		return fmt.Errorf("user %q doesn't exist", login)
+	}
+
+	if role != "member" && role != "maintainer" {
+		return fmt.Errorf("invalid team role %q", role)
+	}
+
+	s.setMembership(o, t, login, role)
+
+	return nil
+}
+
+// teamBySlug returns the team with the slug.
+func (o *organization) teamBySlug(slug string) (*team, bool) {
+	for _, t := range o.teams {
+		if strings.EqualFold(t.slug, slug) {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+// teamByID returns the team with the ID.
+func (o *organization) teamByID(id int64) (*team, bool) {
+	for _, t := range o.teams {
+		if t.id == id {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+// isAncestor returns true if the team with the ID is the team or one of its parents.
+func (o *organization) isAncestor(id int64, t *team) bool {
+	for t != nil {
+		if t.id == id {
+			return true
+		}
+		t, _ = o.teamByID(t.parentID)
+	}
+
+	return false
+}
+
+// deleteTeam deletes the team and its child teams.
+func (o *organization) deleteTeam(t *team) {
+	o.teams = slices.DeleteFunc(o.teams, func(c *team) bool { return c == t })
+
+	for _, c := range slices.Clone(o.teams) {
+		if c.parentID == t.id {
+			o.deleteTeam(c)
+		}
+	}
+}
+
+// createTeam creates a team in the organization, if the team can't be created the error response is returned instead.
+func (s *Server) createTeam(o *organization, nt github.NewTeam) (*team, int, any) {
+	if len(nt.Name) == 0 {
+		status, body := validationFailed("Team", "name", "missing_field", "")
+		return nil, status, body
+	}
+
+	slug, _ := ghutil.TeamSlug(nt.Name)
+	if _, ok := o.teamBySlug(slug); ok {
+		status, body := validationFailed("Team", "name", "already_exists", "Name must be unique for this org")
+		return nil, status, body
+	}
+
+	t := &team{
+		id:                  s.nextID(),
+		name:                nt.Name,
+		slug:                slug,
+		description:         nt.GetDescription(),
+		privacy:             nt.GetPrivacy(),
+		notificationSetting: nt.GetNotificationSetting(),
+		permission:          "pull",
+		parentID:            nt.GetParentTeamID(),
+		memberships:         map[string]*membership{},
+		repositories:        map[int64]string{},
+		ldapDN:              nt.GetLDAPDN(),
+		reviewAssignment:    reviewAssignment{algorithm: "ROUND_ROBIN", memberCount: 1, notifyTeam: true},
+	}
+
+	if t.parentID != 0 {
+		if _, ok := o.teamByID(t.parentID); !ok {
+			status, body := validationFailed("Team", "parent_team_id", "invalid", "Parent team must exist")
+			return nil, status, body
+		}
+	}
+
+	if len(t.privacy) == 0 {
+		t.privacy = "secret"
+		if t.parentID != 0 {
+			t.privacy = "closed"
+		}
+	}
+	if status, body, ok := validateTeam(t); !ok {
+		return nil, status, body
+	}
+
+	for _, login := range nt.Maintainers {
+		if _, ok := s.user(login); !ok {
+			status, body := validationFailed("Team", "maintainers", "invalid", fmt.Sprintf("User %s doesn't exist", login))
+			return nil, status, body
+		}
+	}
+
+	o.teams = append(o.teams, t)
+
+	s.setMembership(o, t, s.viewer, "maintainer")
+	for _, login := range nt.Maintainers {
+		s.setMembership(o, t, login, "maintainer")
+	}
+
+	return t, http.StatusCreated, nil
+}
+
+// validateTeam validates the settings of a team.
+func validateTeam(t *team) (int, any, bool) {
+	if t.privacy != "secret" && t.privacy != "closed" {
+		status, body := validationFailed("Team", "privacy", "invalid", "Privacy must be one of secret or closed")
+		return status, body, false
+	}
+
+	if t.parentID != 0 && t.privacy == "secret" {
+		status, body := validationFailed("Team", "privacy", "invalid", "A team with a parent must be closed")
+		return status, body, false
+	}
+
+	switch t.notificationSetting {
+	case "":
+		t.notificationSetting = "notifications_enabled"
+	case "notifications_enabled", "notifications_disabled":
+	default:
+		status, body := validationFailed("Team", "notification_setting", "invalid", "")
+		return status, body, false
+	}
+
+	return 0, nil, true
+}
+
+// setMembership creates or updates the team membership of the user, new memberships are pending if the user isn't a member of
+// the organization.
+func (s *Server) setMembership(o *organization, t *team, login, role string) *membership {
+	u, _ := s.user(login)
+	key := strings.ToLower(u.GetLogin())
+
+	if m, ok := t.memberships[key]; ok {
+		m.role = role
+		return m
+	}
+
+	m := &membership{login: u.GetLogin(), role: role, state: "active"}
+	if _, ok := o.members[key]; !ok {
+		m.state = "pending"
+		m.invitationID = s.nextID()
+		m.invitedAt = time.Now()
+	}
+	t.memberships[key] = m
+
+	return m
+}
+
+// teamJSON returns the API representation of a team.
+func (s *Server) teamJSON(o *organization, t *team) *github.Team {
+	count := 0
+	for _, m := range t.memberships {
+		if m.state == "active" {
+			count++
+		}
+	}
+
+	gt := &github.Team{
+		ID:                  github.Ptr(t.id),
+		NodeID:              github.Ptr(teamNodeID(t)),
+		Name:                github.Ptr(t.name),
+		Slug:                github.Ptr(t.slug),
+		Description:         github.Ptr(t.description),
+		Privacy:             github.Ptr(t.privacy),
+		NotificationSetting: github.Ptr(t.notificationSetting),
+		Permission:          github.Ptr(t.permission),
+		MembersCount:        github.Ptr(count),
+		Organization:        simpleOrganization(o),
+	}
+
+	if len(t.ldapDN) != 0 {
+		gt.LDAPDN = github.Ptr(t.ldapDN)
+	}
+
+	if p, ok := o.teamByID(t.parentID); ok {
+		gt.Parent = &github.Team{ID: github.Ptr(p.id), Name: github.Ptr(p.name), Slug: github.Ptr(p.slug)}
+	}
+
+	return gt
+}
+
+// teamNodeID returns the GraphQL node ID of a team.
+func teamNodeID(t *team) string {
+	return fmt.Sprintf("T_%d", t.id)
+}
+
+// teamByNodeID returns the team with the GraphQL node ID.
+func (s *Server) teamByNodeID(id string) (*team, bool) {
+	for _, o := range s.orgs {
+		for _, t := range o.teams {
+			if teamNodeID(t) == id {
+				return t, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// membershipJSON returns the API representation of a team membership.
+func membershipJSON(m *membership) *github.Membership {
+	return &github.Membership{Role: github.Ptr(m.role), State: github.Ptr(m.state)}
+}
+
+// lookupTeam returns the organization and team for a request using either the organization login and team slug or the
+// organization ID and team ID path values.
+func (s *Server) lookupTeam(r *http.Request) (*organization, *team, bool) {
+	if id := r.PathValue("org_id"); len(id) != 0 {
+		o, ok := s.organizationByID(id)
+		if !ok {
+			return nil, nil, false
+		}
+
+		teamID, err := strconv.ParseInt(r.PathValue("team_id"), 10, 64)
+		if err != nil {
+			return nil, nil, false
+		}
+
+		t, ok := o.teamByID(teamID)
+		return o, t, ok
+	}
+
+	o, ok := s.organization(r.PathValue("org"))
+	if !ok {
+		return nil, nil, false
+	}
+
+	t, ok := o.teamBySlug(r.PathValue("team_slug"))
+	return o, t, ok
+}
+
+// routeTeams registers the team endpoints.
+func (s *Server) routeTeams() {
+	s.handle("GET /orgs/{org}/teams", func(h http.Header, r *http.Request) (int, any) {
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		teams := make([]*github.Team, 0, len(o.teams))
+		for _, t := range o.teams {
+			teams = append(teams, s.teamJSON(o, t))
+		}
+
+		return http.StatusOK, paginate(h, r, teams)
+	})
+
+	s.handle("POST /orgs/{org}/teams", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		var nt github.NewTeam
+		if err := decode(r, &nt); err != nil {
+			return badRequest()
+		}
+
+		t, status, body := s.createTeam(o, nt)
+		if t == nil {
+			return status, body
+		}
+
+		return http.StatusCreated, s.teamJSON(o, t)
+	})
+
+	get := func(h http.Header, r *http.Request) (int, any) {
+		o, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, s.teamJSON(o, t)
+	}
+	s.handle("GET /orgs/{org}/teams/{team_slug}", get)
+	s.handle("GET /organizations/{org_id}/team/{team_id}", get)
+
+	edit := func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		var fields map[string]json.RawMessage
+		if err := decode(r, &fields); err != nil {
+			return badRequest()
+		}
+
+		updated := *t
+		for k, v := range fields {
+			var err error
+			switch k {
+			case "name":
+				err = json.Unmarshal(v, &updated.name)
+			case "description":
+				err = json.Unmarshal(v, &updated.description)
+			case "privacy":
+				err = json.Unmarshal(v, &updated.privacy)
+			case "notification_setting":
+				err = json.Unmarshal(v, &updated.notificationSetting)
+			case "permission":
+				err = json.Unmarshal(v, &updated.permission)
+			case "ldap_dn":
+				err = json.Unmarshal(v, &updated.ldapDN)
+			case "parent_team_id":
+				var id *int64
+				err = json.Unmarshal(v, &id)
+				updated.parentID = 0
+				if id != nil {
+					updated.parentID = *id
+				}
+			}
+			if err != nil {
+				return badRequest()
+			}
+		}
+
+		if updated.name != t.name {
+			updated.slug, _ = ghutil.TeamSlug(updated.name)
+			if c, ok := o.teamBySlug(updated.slug); ok && c != t {
+				return validationFailed("Team", "name", "already_exists", "Name must be unique for this org")
+			}
+		}
+
+		if updated.parentID != 0 {
+			p, ok := o.teamByID(updated.parentID)
+			if !ok || o.isAncestor(t.id, p) {
+				return validationFailed("Team", "parent_team_id", "invalid", "Parent team is invalid")
+			}
+		}
+
+		if status, body, ok := validateTeam(&updated); !ok {
+			return status, body
+		}
+
+		*t = updated
+
+		return http.StatusOK, s.teamJSON(o, t)
+	}
+	s.handle("PATCH /orgs/{org}/teams/{team_slug}", edit)
+	s.handle("PATCH /organizations/{org_id}/team/{team_id}", edit)
+
+	del := func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		o.deleteTeam(t)
+
+		return http.StatusNoContent, nil
+	}
+	s.handle("DELETE /orgs/{org}/teams/{team_slug}", del)
+	s.handle("DELETE /organizations/{org_id}/team/{team_id}", del)
+
+	s.handle("GET /orgs/{org}/teams/{team_slug}/members", func(h http.Header, r *http.Request) (int, any) {
+		_, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		role := r.URL.Query().Get("role")
+		if len(role) == 0 {
+			role = "all"
+		}
+
+		var users []*github.User
+		for _, m := range t.memberships {
+			if m.state != "active" || (role != "all" && role != m.role) {
+				continue
+			}
+
+			u, _ := s.user(m.login)
+			users = append(users, simpleUser(u))
+		}
+		slices.SortFunc(users, func(a, b *github.User) int {
+			return strings.Compare(strings.ToLower(a.GetLogin()), strings.ToLower(b.GetLogin()))
+		})
+
+		return http.StatusOK, paginate(h, r, users)
+	})
+
+	s.handle("GET /orgs/{org}/teams/{team_slug}/invitations", func(h http.Header, r *http.Request) (int, any) {
+		_, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		viewer, _ := s.user(s.viewer)
+
+		var invitations []*github.Invitation
+		for _, m := range t.memberships {
+			if m.state != "pending" {
+				continue
+			}
+
+			invitations = append(invitations, &github.Invitation{
+				ID:        github.Ptr(m.invitationID),
+				Login:     github.Ptr(m.login),
+				Role:      github.Ptr("direct_member"),
+				CreatedAt: &github.Timestamp{Time: m.invitedAt},
+				Inviter:   simpleUser(viewer),
+			})
+		}
+		slices.SortFunc(invitations, func(a, b *github.Invitation) int {
+			return strings.Compare(strings.ToLower(a.GetLogin()), strings.ToLower(b.GetLogin()))
+		})
+
+		return http.StatusOK, paginate(h, r, invitations)
+	})
+
+	s.handle("GET /orgs/{org}/teams/{team_slug}/memberships/{username}", func(h http.Header, r *http.Request) (int, any) {
+		_, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		m, ok := t.memberships[strings.ToLower(r.PathValue("username"))]
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, membershipJSON(m)
+	})
+
+	s.handle("PUT /orgs/{org}/teams/{team_slug}/memberships/{username}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		if _, ok := s.user(r.PathValue("username")); !ok {
+			return notFound()
+		}
+
+		var opts github.TeamAddTeamMembershipOptions
+		if r.ContentLength != 0 {
+			if err := decode(r, &opts); err != nil {
+				return badRequest()
+			}
+		}
+
+		switch opts.Role {
+		case "":
+			opts.Role = "member"
+		case "member", "maintainer":
+		default:
+			return validationFailed("TeamMember", "role", "invalid", "")
+		}
+
+		return http.StatusOK, membershipJSON(s.setMembership(o, t, r.PathValue("username"), opts.Role))
+	})
+
+	s.handle("DELETE /orgs/{org}/teams/{team_slug}/memberships/{username}", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		_, t, ok := s.lookupTeam(r)
+		if !ok {
+			return notFound()
+		}
+
+		key := strings.ToLower(r.PathValue("username"))
+		if _, ok := t.memberships[key]; !ok {
+			return notFound()
+		}
+		delete(t.memberships, key)
+
+		return http.StatusNoContent, nil
+	})
+
+	s.handleGraphQL("TeamCodeReviewAssignment", func(r *http.Request, variables json.RawMessage) (any, []graphqlError) {
+		var vars struct {
+			Organization string `json:"organization"`
+			Slug         string `json:"slug"`
+		}
+		if err := json.Unmarshal(variables, &vars); err != nil {
+			return nil, []graphqlError{{Message: err.Error()}}
+		}
+
+		o, ok := s.organization(vars.Organization)
+		if !ok {
+			return map[string]any{"organization": nil}, graphqlNotFound("Could not resolve to an Organization with the login of '%s'.", vars.Organization)
+		}
+
+		t, ok := o.teamBySlug(vars.Slug)
+		if !ok {
+			return map[string]any{"organization": map[string]any{"team": nil}}, nil
+		}
+
+		return map[string]any{"organization": map[string]any{"team": map[string]any{
+			"reviewRequestDelegationEnabled":     t.reviewAssignment.enabled,
+			"reviewRequestDelegationAlgorithm":   t.reviewAssignment.algorithm,
+			"reviewRequestDelegationMemberCount": t.reviewAssignment.memberCount,
+			"reviewRequestDelegationNotifyTeam":  t.reviewAssignment.notifyTeam,
+		}}}, nil
+	})
+
+	s.handleGraphQL("UpdateTeamReviewAssignment", func(r *http.Request, variables json.RawMessage) (any, []graphqlError) {
+		var vars struct {
+			Input struct {
+				ID              string  `json:"id"`
+				Enabled         bool    `json:"enabled"`
+				Algorithm       *string `json:"algorithm"`
+				TeamMemberCount *int    `json:"teamMemberCount"`
+				NotifyTeam      *bool   `json:"notifyTeam"`
+			} `json:"input"`
+		}
+		if err := json.Unmarshal(variables, &vars); err != nil {
+			return nil, []graphqlError{{Message: err.Error()}}
+		}
+
+		t, ok := s.teamByNodeID(vars.Input.ID)
+		if !ok {
+			return map[string]any{"updateTeamReviewAssignment": nil}, graphqlNotFound("Could not resolve to a node with the global id of '%s'.", vars.Input.ID)
+		}
+
+		updated := t.reviewAssignment
+		updated.enabled = vars.Input.Enabled
+		if vars.Input.Algorithm != nil {
+			updated.algorithm = *vars.Input.Algorithm
+		}
+		if vars.Input.TeamMemberCount != nil {
+			updated.memberCount = *vars.Input.TeamMemberCount
+		}
+		if vars.Input.NotifyTeam != nil {
+			updated.notifyTeam = *vars.Input.NotifyTeam
+		}
+
+		if updated.algorithm != "ROUND_ROBIN" && updated.algorithm != "LOAD_BALANCE" {
+			return map[string]any{"updateTeamReviewAssignment": nil}, []graphqlError{{Type: "INVALID_ARGUMENT", Message: fmt.Sprintf("Algorithm %q is invalid.", updated.algorithm)}}
+		}
+		if updated.memberCount < 1 {
+			return map[string]any{"updateTeamReviewAssignment": nil}, []graphqlError{{Type: "INVALID_ARGUMENT", Message: "Team member count must be at least 1."}}
+		}
+
+		t.reviewAssignment = updated
+
+		return map[string]any{"updateTeamReviewAssignment": map[string]any{"team": map[string]any{"id": teamNodeID(t)}}}, nil
+	})
+}
@@ -0,0 +1,152 @@
+package ghfake
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// AddUser adds a user to the server and returns it, an ID is generated if the user doesn't have one.
+func (s *Server) AddUser(u github.User) github.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if u.ID == nil {
+		u.ID = github.Ptr(s.nextID())
+	} else {
+		s.lastID = max(s.lastID, u.GetID())
+	}
+	u.Type = github.Ptr("User")
+
+	s.users[strings.ToLower(u.GetLogin())] = &u
+
+	return u
+}
+
+// user returns the user with the login.
+func (s *Server) user(login string) (*github.User, bool) {
+	u, ok := s.users[strings.ToLower(login)]
+	return u, ok
+}
+
+// simpleUser returns the short representation of a user that is embedded in other responses.
+func simpleUser(u *github.User) *github.User {
+	return &github.User{ID: u.ID, Login: u.Login, Type: u.Type}
+}
+
+// organizationUser returns the user representation of an organization, which is returned by the user endpoints.
+func organizationUser(o *organization) *github.User {
+	return &github.User{ID: o.org.ID, Login: o.org.Login, Name: o.org.Name, Type: github.Ptr("Organization")}
+}
+
+// installation returns the installation of the app for an organization, every organization has an installation with the
+// same ID as the organization.
+func installation(o *organization) *github.Installation {
+	return &github.Installation{ID: o.org.ID, Account: organizationUser(o), TargetType: github.Ptr("Organization")}
+}
+
+// routeApps registers the GitHub App endpoints.
+func (s *Server) routeApps() {
+	s.handle("GET /orgs/{org}/installation", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		o, ok := s.organization(r.PathValue("org"))
+		if !ok {
+			return notFound()
+		}
+
+		return http.StatusOK, installation(o)
+	})
+
+	s.handle("POST /app/installations/{installation_id}/access_tokens", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		id, err := strconv.ParseInt(r.PathValue("installation_id"), 10, 64)
+		if err != nil {
+			return notFound()
+		}
+
+		var opts github.InstallationTokenOptions
+		if r.ContentLength != 0 {
+			if err := decode(r, &opts); err != nil {
+				return badRequest()
+			}
+		}
+
+		token := github.InstallationToken{
+			Token:       github.Ptr(fmt.Sprintf("ghs_fake%d", id)),
+			ExpiresAt:   &github.Timestamp{Time: time.Now().Add(time.Hour)},
+			Permissions: opts.Permissions,
+		}
+
+		// Installations which aren't for an organization are allowed so that the default installation can be used without
+		// seeding one.
+		o, ok := s.organizationByID(fmt.Sprint(id))
+		for _, name := range opts.Repositories {
+			if !ok {
+				return notFound()
+			}
+
+			repo, ok := s.repos[repositoryKey(o.org.GetLogin(), name)]
+			if !ok {
+				return validationFailed("InstallationToken", "repositories", "invalid", fmt.Sprintf("There is at least one repository that does not exist or is not accessible to the parent installation: %s", name))
+			}
+			token.Repositories = append(token.Repositories, repo.repo)
+		}
+
+		return http.StatusCreated, token
+	})
+}
+
+// routeUsers registers the user endpoints.
+func (s *Server) routeUsers() {
+	s.handle("GET /user", func(h http.Header, r *http.Request) (int, any) {
+		if !authenticated(r) {
+			return unauthorized()
+		}
+
+		u, _ := s.user(s.viewer)
+		return http.StatusOK, u
+	})
+
+	s.handle("GET /user/{account_id}", func(h http.Header, r *http.Request) (int, any) {
+		id, err := strconv.ParseInt(r.PathValue("account_id"), 10, 64)
+		if err != nil {
+			return notFound()
+		}
+
+		for _, u := range s.users {
+			if u.GetID() == id {
+				return http.StatusOK, u
+			}
+		}
+
+		for _, o := range s.orgs {
+			if o.org.GetID() == id {
+				return http.StatusOK, organizationUser(o)
+			}
+		}
+
+		return notFound()
+	})
+
+	s.handle("GET /users/{username}", func(h http.Header, r *http.Request) (int, any) {
+		if u, ok := s.user(r.PathValue("username")); ok {
+			return http.StatusOK, u
+		}
+
+		if o, ok := s.organization(r.PathValue("username")); ok {
+			return http.StatusOK, organizationUser(o)
+		}
+
+		return notFound()
+	})
+}
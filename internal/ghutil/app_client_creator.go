@@ -3,6 +3,8 @@ package ghutil
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/go-github/v74/github"
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -10,24 +12,42 @@ import (
 
 // appClientCreator is responsible for creating GitHub clients using app authentication.
 type appClientCreator struct {
-	appID         int64
-	privateKey    []byte
-	cacheRequests bool
-	clients       *lru.Cache[string, *github.Client]
+	appID          int64
+	key            AppKey
+	installationID int64
+	defaultOwner   string
+	scope          TokenScope
+	opts           ClientOptions
+	clients        *lru.Cache[string, *github.Client]
 }
 
-// NewAppClientCreator creates a ClientCreator than can authenticate using a GitHub app.
-func NewAppClientCreator(appID int64, privateKey []byte, capacity int, cacheRequests bool) (ClientCreator, error) {
-	cache, err := lru.New[string, *github.Client](capacity)
+// NewAppClientCreator creates a ClientCreator than can authenticate using a GitHub app. The installation used for the default client
+// is selected by installationID if it isn't 0, otherwise by defaultOwner if it isn't empty, otherwise the app must only have a single
+// installation. Installation tokens are limited to the scope unless a client is requested with a different scope.
+func NewAppClientCreator(appID int64, key AppKey, installationID int64, defaultOwner string, scope TokenScope, capacity int, opts ClientOptions) (ClientCreator, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid token scope: %w", err)
+	}
+
+	// The private key is parsed once rather than for each client.
+	signer, err := key.signer()
+	if err != nil {
+		return nil, fmt.Errorf("invalid app key: %w", err)
+	}
+
+	clients, err := lru.New[string, *github.Client](capacity)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client cache: %w", err)
 	}
 
 	cc := &appClientCreator{
-		appID:         appID,
-		privateKey:    privateKey,
-		cacheRequests: cacheRequests,
-		clients:       cache,
+		appID:          appID,
+		key:            AppKey{Signer: signer},
+		installationID: installationID,
+		defaultOwner:   defaultOwner,
+		scope:          scope,
+		opts:           opts,
+		clients:        clients,
 	}
 
 	return cc, nil
@@ -41,7 +61,7 @@ func (cc *appClientCreator) AppClient() (*github.Client, error) {
 		return c, nil
 	}
 
-	c, err := NewGitHubClientForApp(cc.appID, cc.privateKey, -1, cc.cacheRequests)
+	c, err := NewGitHubClientForApp(cc.appID, cc.key, -1, TokenScope{}, cc.opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create github client: %w", err)
 	}
@@ -58,34 +78,30 @@ func (cc *appClientCreator) DefaultClient(ctx context.Context) (*github.Client,
 		return c, nil
 	}
 
-	ac, err := cc.AppClient()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get app client: %w", err)
-	}
-
-	insts, _, err := ac.Apps.ListInstallations(ctx, &github.ListOptions{})
+	inst, err := cc.defaultInstallation(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list installations: %w", err)
-	}
-
-	if len(insts) == 0 {
-		return nil, fmt.Errorf("no app installations found")
+		return nil, err
 	}
 
-	inst := insts[0]
-	c, err = NewGitHubClientForApp(cc.appID, cc.privateKey, inst.GetID(), cc.cacheRequests)
+	c, err = NewGitHubClientForApp(cc.appID, cc.key, inst.GetID(), cc.scope, cc.opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create installation client: %w", err)
 	}
 	cc.clients.Add(key, c)
-	cc.clients.Add(inst.GetAccount().GetLogin(), c)
+	cc.clients.Add(organizationClientKey(inst.GetAccount().GetLogin(), cc.scope), c)
 
 	return c, nil
 }
 
 // OrganizationClient returns a GitHub client for an organization.
 func (cc *appClientCreator) OrganizationClient(ctx context.Context, organization string) (*github.Client, error) {
-	c, ok := cc.clients.Get(organization)
+	return cc.ScopedOrganizationClient(ctx, organization, cc.scope)
+}
+
+// ScopedOrganizationClient returns a GitHub client for an organization with an installation token limited to the scope.
+func (cc *appClientCreator) ScopedOrganizationClient(ctx context.Context, organization string, scope TokenScope) (*github.Client, error) {
+	key := organizationClientKey(organization, scope)
+	c, ok := cc.clients.Get(key)
 	if ok {
 		return c, nil
 	}
@@ -100,11 +116,73 @@ func (cc *appClientCreator) OrganizationClient(ctx context.Context, organization
 		return nil, fmt.Errorf("failed to get installation ID: %w", err)
 	}
 
-	c, err = NewGitHubClientForApp(cc.appID, cc.privateKey, inst.GetID(), cc.cacheRequests)
+	c, err = NewGitHubClientForApp(cc.appID, cc.key, inst.GetID(), scope, cc.opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create installation client: %w", err)
 	}
-	cc.clients.Add(organization, c)
+	cc.clients.Add(key, c)
 
 	return c, nil
 }
+
+// organizationClientKey returns the client cache key for an organization and token scope.
+func organizationClientKey(organization string, scope TokenScope) string {
+	if scope.IsZero() {
+		return strings.ToLower(organization)
+	}
+
+	return fmt.Sprintf("%s|%s", strings.ToLower(organization), scope.Key())
+}
+
+// defaultInstallation returns the installation to use for the default client.
+func (cc *appClientCreator) defaultInstallation(ctx context.Context) (*github.Installation, error) {
+	ac, err := cc.AppClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app client: %w", err)
+	}
+
+	if cc.installationID != 0 {
+		inst, _, err := ac.Apps.GetInstallation(ctx, cc.installationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get installation %d: %w", cc.installationID, err)
+		}
+		return inst, nil
+	}
+
+	insts, err := ListAll(func(opts github.ListOptions) ([]*github.Installation, *github.Response, error) {
+		return ac.Apps.ListInstallations(ctx, &opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list installations: %w", err)
+	}
+
+	return selectInstallation(insts, cc.defaultOwner)
+}
+
+// selectInstallation deterministically selects an installation, either by the owner login or because it is the only one.
+func selectInstallation(insts []*github.Installation, owner string) (*github.Installation, error) {
+	if len(insts) == 0 {
+		return nil, fmt.Errorf("no app installations found")
+	}
+
+	if len(owner) != 0 {
+		for _, inst := range insts {
+			if strings.EqualFold(inst.GetAccount().GetLogin(), owner) {
+				return inst, nil
+			}
+		}
+		return nil, fmt.Errorf("no app installation found for owner %q", owner)
+	}
+
+	if len(insts) == 1 {
+		return insts[0], nil
+	}
+
+	owners := make([]string, 0, len(insts))
+	for _, inst := range insts {
+		owners = append(owners, inst.GetAccount().GetLogin())
+	}
+	slices.Sort(owners)
+
+	return nil, fmt.Errorf("app has %d installations (%s); set installation_id or default_owner to select the default installation", len(insts), strings.Join(owners, ", "))
+}
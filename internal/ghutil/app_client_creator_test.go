@@ -0,0 +1,164 @@
+package ghutil
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestSelectInstallation(t *testing.T) {
+	newInstallation := func(id int64, login string) *github.Installation {
+		return &github.Installation{ID: github.Ptr(id), Account: &github.User{Login: github.Ptr(login)}}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		insts   []*github.Installation
+		owner   string
+		wantID  int64
+		wantErr string
+	}{
+		{
+			name:    "no_installations",
+			wantErr: "no app installations found",
+		},
+		{
+			name:   "single_installation",
+			insts:  []*github.Installation{newInstallation(1, "org-a")},
+			wantID: 1,
+		},
+		{
+			name:   "owner",
+			insts:  []*github.Installation{newInstallation(1, "org-a"), newInstallation(2, "org-b")},
+			owner:  "org-b",
+			wantID: 2,
+		},
+		{
+			name:   "owner_case_insensitive",
+			insts:  []*github.Installation{newInstallation(1, "org-a"), newInstallation(2, "Org-B")},
+			owner:  "org-b",
+			wantID: 2,
+		},
+		{
+			name:    "owner_not_found",
+			insts:   []*github.Installation{newInstallation(1, "org-a")},
+			owner:   "org-b",
+			wantErr: `no app installation found for owner "org-b"`,
+		},
+		{
+			name:    "ambiguous",
+			insts:   []*github.Installation{newInstallation(2, "org-b"), newInstallation(1, "org-a")},
+			wantErr: "app has 2 installations (org-a, org-b); set installation_id or default_owner to select the default installation",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			inst, err := selectInstallation(tc.insts, tc.owner)
+			if len(tc.wantErr) != 0 {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("expected error %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if inst.GetID() != tc.wantID {
+				t.Errorf("expected installation %d, got: %d", tc.wantID, inst.GetID())
+			}
+		})
+	}
+}
+
+func TestAppClientCreatorScopes(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	var mu sync.Mutex
+	var tokenRequests []map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v3/orgs/test-org/installation":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 2})
+		case "/api/v3/app/installations/2/access_tokens":
+			body := map[string]any{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			tokenRequests = append(tokenRequests, body)
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"token": "installation-token", "expires_at": time.Now().Add(time.Hour)})
+		case "/api/v3/user":
+			_ = json.NewEncoder(w).Encode(map[string]any{"login": "octocat"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	scope := TokenScope{Permissions: map[string]string{"contents": "read", "members": "write"}, Repositories: []string{"repo-a"}}
+	cc, err := NewAppClientCreator(1, AppKey{PEM: privateKey}, 0, "", scope, 10, ClientOptions{BaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c1, err := cc.OrganizationClient(t.Context(), "test-org")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c2, err := cc.ScopedOrganizationClient(t.Context(), "Test-Org", TokenScope{Permissions: map[string]string{"members": "write", "contents": "read"}, Repositories: []string{"Repo-A"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c1 != c2 {
+		t.Fatal("expected equivalent scopes to share a client")
+	}
+
+	c3, err := cc.ScopedOrganizationClient(t.Context(), "test-org", TokenScope{Permissions: map[string]string{"members": "read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c1 == c3 {
+		t.Fatal("expected different scopes to use different clients")
+	}
+
+	for _, c := range []*github.Client{c1, c3} {
+		if _, _, err := c.Users.Get(t.Context(), ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{
+		`{"permissions":{"contents":"read","members":"write"},"repositories":["repo-a"]}`,
+		`{"permissions":{"members":"read"}}`,
+	}
+	if len(tokenRequests) != len(want) {
+		t.Fatalf("expected %d token requests, got %d", len(want), len(tokenRequests))
+	}
+	for i, body := range tokenRequests {
+		b, _ := json.Marshal(body)
+		if string(b) != want[i] {
+			t.Errorf("expected token request %s, got %s", want[i], b)
+		}
+	}
+
+	if _, err := NewAppClientCreator(1, AppKey{PEM: privateKey}, 0, "", TokenScope{Permissions: map[string]string{"contents": "none"}}, 10, ClientOptions{BaseURL: srv.URL}); err == nil {
+		t.Fatal("expected an invalid scope error")
+	}
+}
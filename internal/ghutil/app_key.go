@@ -0,0 +1,130 @@
+package ghutil
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/youmark/pkcs8"
+)
+
+// AppKey is the key used to sign the JWTs which authenticate a GitHub app, either a private key or a signer.
+type AppKey struct {
+	// PEM is the PEM encoded RSA private key, which can also be base64 encoded; PKCS #1 and PKCS #8 keys are supported.
+	PEM []byte
+	// Passphrase decrypts an encrypted PKCS #8 private key.
+	Passphrase string
+	// Signer signs the JWTs instead of the private key, which allows the key to be kept in an external system; the signer must
+	// support RSASSA-PKCS1-v1_5 signatures of SHA-256 digests. If this is set PEM is ignored.
+	Signer crypto.Signer
+}
+
+// signer returns the signer for the key, parsing the private key if there isn't a signer.
+func (k AppKey) signer() (crypto.Signer, error) {
+	if k.Signer != nil {
+		return k.Signer, nil
+	}
+
+	return parsePrivateKey(k.PEM, k.Passphrase)
+}
+
+// appJWTSigner signs GitHub app JWTs using a crypto.Signer.
+type appJWTSigner struct {
+	signer crypto.Signer
+}
+
+// Sign signs the JWT claims.
+func (s appJWTSigner) Sign(claims jwt.Claims) (string, error) {
+	return jwt.NewWithClaims(signingMethodRS256, claims).SignedString(s.signer)
+}
+
+// signingMethodRS256 is the RS256 JWT signing method using a crypto.Signer as the key, the jwt package only supports RSA private
+// keys.
+var signingMethodRS256 = &signerSigningMethod{}
+
+// signerSigningMethod is a jwt.SigningMethod which signs with a crypto.Signer.
+type signerSigningMethod struct{}
+
+// Alg returns the JWT algorithm.
+func (m *signerSigningMethod) Alg() string {
+	return jwt.SigningMethodRS256.Alg()
+}
+
+// Sign signs the signing string with the key, which must be a crypto.Signer.
+func (m *signerSigningMethod) Sign(signingString string, key any) (string, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+
+	digest := sha256.Sum256([]byte(signingString))
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return jwt.EncodeSegment(sig), nil
+}
+
+// Verify isn't supported as the JWTs are only verified by GitHub.
+func (m *signerSigningMethod) Verify(signingString, signature string, key any) error {
+	return jwt.ErrSignatureInvalid
+}
+
+// parsePrivateKey parses a PEM encoded RSA private key, which can also be base64 encoded and can be an encrypted PKCS #8 key.
+func parsePrivateKey(data []byte, passphrase string) (*rsa.PrivateKey, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("private key is empty")
+	}
+
+	if !bytes.HasPrefix(data, []byte("-----BEGIN")) {
+		decoded, err := base64.StdEncoding.DecodeString(string(bytes.Join(bytes.Fields(data), nil)))
+		if err != nil {
+			return nil, fmt.Errorf("private key isn't PEM or base64 encoded PEM")
+		}
+		data = decoded
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("private key isn't PEM encoded")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "ENCRYPTED PRIVATE KEY":
+		if len(passphrase) == 0 {
+			return nil, fmt.Errorf("private key is encrypted but no passphrase was provided")
+		}
+
+		key, err = pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(passphrase))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt private key: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported private key type %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key must be an RSA key, got %T", key)
+	}
+
+	return rsaKey, nil
+}
@@ -0,0 +1,206 @@
+package ghutil
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/youmark/pkcs8"
+)
+
+// testEncryptPKCS8 encrypts a private key as PKCS #8 with PBES2 using PBKDF2 and AES-CBC with the hash and cipher.
+func testEncryptPKCS8(t *testing.T, key any, passphrase string, hash crypto.Hash, c pkcs8.Cipher) []byte {
+	t.Helper()
+
+	b, err := pkcs8.MarshalPrivateKey(key, []byte(passphrase), &pkcs8.Opts{
+		Cipher:  c,
+		KDFOpts: pkcs8.PBKDF2Opts{SaltSize: 16, IterationCount: 2048, HMACHash: hash},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: b})
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPKCS8, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkcs1PEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	encrypted := testEncryptPKCS8(t, key, "correct horse", crypto.SHA256, pkcs8.AES256CBC)
+
+	for _, tc := range []struct {
+		name       string
+		data       []byte
+		passphrase string
+		wantErr    string
+	}{
+		{
+			name: "pkcs1",
+			data: pkcs1PEM,
+		},
+		{
+			name: "pkcs8",
+			data: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER}),
+		},
+		{
+			name: "base64",
+			data: []byte(base64.StdEncoding.EncodeToString(pkcs1PEM)),
+		},
+		{
+			name: "base64_wrapped",
+			data: []byte(strings.Join(strings.SplitAfter(base64.StdEncoding.EncodeToString(pkcs1PEM), "="), "\n") + "\n"),
+		},
+		{
+			name:       "encrypted",
+			data:       encrypted,
+			passphrase: "correct horse",
+		},
+		{
+			name:       "encrypted_sha1_aes128",
+			data:       testEncryptPKCS8(t, key, "correct horse", crypto.SHA1, pkcs8.AES128CBC),
+			passphrase: "correct horse",
+		},
+		{
+			name:       "encrypted_base64",
+			data:       []byte(base64.StdEncoding.EncodeToString(encrypted)),
+			passphrase: "correct horse",
+		},
+		{
+			name:    "encrypted_no_passphrase",
+			data:    encrypted,
+			wantErr: "private key is encrypted but no passphrase was provided",
+		},
+		{
+			name:       "encrypted_wrong_passphrase",
+			data:       encrypted,
+			passphrase: "battery staple",
+			wantErr:    "failed to ",
+		},
+		{
+			name:    "not_rsa",
+			data:    pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: ecPKCS8}),
+			wantErr: "private key must be an RSA key",
+		},
+		{
+			name:    "invalid",
+			data:    []byte("not a key"),
+			wantErr: "private key isn't PEM or base64 encoded PEM",
+		},
+		{
+			name:    "empty",
+			wantErr: "private key is empty",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parsePrivateKey(tc.data, tc.passphrase)
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !got.Equal(key) {
+				t.Fatal("expected the parsed key to equal the original key")
+			}
+		})
+	}
+}
+
+func TestAppJWTSigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed, err := appJWTSigner{signer: key}.Sign(jwt.RegisteredClaims{Issuer: "1", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := jwt.ParseWithClaims(signed, &jwt.RegisteredClaims{}, func(token *jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"RS256"}))
+	if err != nil {
+		t.Fatalf("expected a valid RS256 JWT: %v", err)
+	}
+
+	if iss := token.Claims.(*jwt.RegisteredClaims).Issuer; iss != "1" {
+		t.Errorf("expected issuer %q, got %q", "1", iss)
+	}
+}
+
+func TestCommandSigner(t *testing.T) {
+	digest := sha256.Sum256([]byte("signing input"))
+
+	// The command echoes the digest back, so the signature is the digest.
+	signer, err := NewCommandSigner([]string{"sh", "-c", "cat"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(sig) != string(digest[:]) {
+		t.Errorf("expected the signature to be the command output")
+	}
+
+	if _, err := signer.Sign(rand.Reader, digest[:], crypto.SHA512); err == nil {
+		t.Error("expected an unsupported hash error")
+	}
+
+	failing, err := NewCommandSigner([]string{"sh", "-c", "echo 'key not found' >&2; exit 1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := failing.Sign(rand.Reader, digest[:], crypto.SHA256); err == nil || !strings.Contains(err.Error(), "key not found") {
+		t.Errorf("expected the command error output, got: %v", err)
+	}
+
+	slow := &commandSigner{args: []string{"sh", "-c", "sleep 10"}, timeout: 100 * time.Millisecond}
+	start := time.Now()
+	if _, err := slow.Sign(rand.Reader, digest[:], crypto.SHA256); err == nil || !strings.Contains(err.Error(), "didn't finish within") {
+		t.Errorf("expected a timeout error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the command to be stopped after the timeout, took %s", elapsed)
+	}
+
+	if _, err := NewCommandSigner(nil); err == nil {
+		t.Error("expected an empty command error")
+	}
+}
@@ -0,0 +1,441 @@
+package ghutil
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	ghcht "github.com/bored-engineer/github-conditional-http-transport"
+	"go.etcd.io/bbolt"
+	bbolterrors "go.etcd.io/bbolt/errors"
+)
+
+// CacheCleanupPolicy controls how entries are removed from the on-disk cache.
+type CacheCleanupPolicy string
+
+const (
+	// CacheCleanupExpired removes expired entries and evicts the oldest entries over the maximum size when the cache is opened, and
+	// removes the cache files of other identities which haven't been used within the TTL.
+	CacheCleanupExpired CacheCleanupPolicy = "expired"
+	// CacheCleanupAll removes all entries when the cache is opened, and removes the cache files of other identities which haven't
+	// been used within the TTL.
+	CacheCleanupAll CacheCleanupPolicy = "all"
+	// CacheCleanupNone never removes entries; expired entries are still ignored.
+	CacheCleanupNone CacheCleanupPolicy = "none"
+)
+
+// CacheCleanupPolicies contains all of the valid cache cleanup policies.
+var CacheCleanupPolicies = []CacheCleanupPolicy{CacheCleanupExpired, CacheCleanupAll, CacheCleanupNone}
+
+const (
+	// cacheBucket is the bbolt bucket used to store responses.
+	cacheBucket = "github"
+	// cacheLockTimeout is how long to wait for another process to release the cache file lock.
+	cacheLockTimeout = time.Second
+	// cacheSweepLockTimeout is how long to wait for the lock of another identity's cache file when removing stale files.
+	cacheSweepLockTimeout = 10 * time.Millisecond
+	// cacheStaleFileAge is how long a cache file can go unused before it's removed if entries never expire.
+	cacheStaleFileAge = 30 * 24 * time.Hour
+	// cacheTimestampSize is the size of the timestamp prefixed to each entry.
+	cacheTimestampSize = 8
+)
+
+// CacheOptions configures the persistent on-disk request cache.
+type CacheOptions struct {
+	// Directory is the directory containing the cache files; if empty the user cache directory is used.
+	Directory string
+	// MaxSize is the maximum size in bytes of the cached responses for a single identity; 0 means unlimited. The cache file is
+	// compacted when it's opened if it's larger than this.
+	MaxSize int64
+	// TTL is how long a cached response can be used for; 0 means entries never expire.
+	TTL time.Duration
+	// Cleanup is the policy used to remove entries from the cache.
+	Cleanup CacheCleanupPolicy
+}
+
+// DefaultCacheDirectory returns the default directory for the cache.
+func DefaultCacheDirectory() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+
+	return filepath.Join(dir, "terraform-provider-github")
+}
+
+// cacheFile is a cache file which is held open by the process once it has been opened. A bbolt database can only be open in one
+// process at a time, so if another process holds the file the cache is disabled for the identity rather than waiting for it.
+type cacheFile struct {
+	mu      sync.RWMutex
+	db      *bbolt.DB
+	opened  bool
+	cleaned bool
+}
+
+var (
+	// cacheFiles contains a *cacheFile for each cache file path.
+	cacheFiles sync.Map
+	// cacheSweptDirectories contains the cache directories which have had stale files removed by this process.
+	cacheSweptDirectories sync.Map
+)
+
+// diskStorage implements the ghcht.Storage interface using a bbolt database shared by all of the storages for the same identity.
+type diskStorage struct {
+	path string
+	ttl  time.Duration
+	file *cacheFile
+	now  func() time.Time
+}
+
+var _ ghcht.Storage = &diskStorage{}
+
+// cacheTransport creates a new http.RoundTripper with a persistent cache for the given identity.
+func cacheTransport(tr http.RoundTripper, identity string, opts *CacheOptions) (http.RoundTripper, error) {
+	stor, err := openDiskStorage(identity, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return ghcht.NewTransport(stor, tr), nil
+}
+
+// tokenCacheIdentity returns the cache identity for a token, or for unauthenticated requests if the token is nil.
+func tokenCacheIdentity(token *string) string {
+	if token == nil {
+		return "anonymous"
+	}
+
+	return fmt.Sprintf("token:%s", ghcht.HashToken(*token))
+}
+
+// appCacheIdentity returns the cache identity for a GitHub app installation; an installation ID of -1 is the app itself. Scoped
+// tokens have their own identity as they may not be able to see everything the installation can.
+func appCacheIdentity(appID, installationID int64, scope TokenScope) string {
+	if scope.IsZero() {
+		return fmt.Sprintf("app:%d:%d", appID, installationID)
+	}
+
+	return fmt.Sprintf("app:%d:%d:%s", appID, installationID, scope.Key())
+}
+
+// oidcCacheIdentity returns the cache identity prefix for GitHub tokens from an OIDC token exchange service, the authenticated
+// user or token is added when the client is created.
+func oidcCacheIdentity(exchangeURL string) string {
+	return fmt.Sprintf("oidc:%s", exchangeURL)
+}
+
+// commandCacheIdentity returns the cache identity prefix for GitHub tokens from a command, the authenticated user or token is
+// added when the client is created as the command can return tokens for different users.
+func commandCacheIdentity(args []string) string {
+	return fmt.Sprintf("command:%q", args)
+}
+
+// openDiskStorage opens the cache file for the identity, creating it and applying the cleanup policy if required.
+func openDiskStorage(identity string, opts *CacheOptions) (*diskStorage, error) {
+	dir := opts.Directory
+	if len(dir) == 0 {
+		dir = DefaultCacheDirectory()
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	sum := sha256.Sum256([]byte(identity))
+	p := filepath.Join(dir, fmt.Sprintf("%s.db", hex.EncodeToString(sum[:16])))
+
+	if opts.Cleanup != CacheCleanupNone {
+		if _, swept := cacheSweptDirectories.LoadOrStore(dir, true); !swept {
+			maxAge := opts.TTL
+			if maxAge <= 0 {
+				maxAge = cacheStaleFileAge
+			}
+			sweepCacheDirectory(dir, p, maxAge, time.Now())
+		}
+	}
+
+	f, _ := cacheFiles.LoadOrStore(p, &cacheFile{})
+	s := &diskStorage{
+		path: p,
+		ttl:  opts.TTL,
+		file: f.(*cacheFile),
+		now:  time.Now,
+	}
+
+	if err := s.init(opts.Cleanup, opts.MaxSize); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// init opens the cache file, creates the cache bucket and applies the cleanup policy; cleanup only happens once per process for
+// each file.
+func (s *diskStorage) init(cleanup CacheCleanupPolicy, maxSize int64) error {
+	s.file.mu.Lock()
+	defer s.file.mu.Unlock()
+
+	if !s.file.opened {
+		db, err := bbolt.Open(s.path, 0o600, &bbolt.Options{Timeout: cacheLockTimeout})
+		if err != nil && !errors.Is(err, bbolterrors.ErrTimeout) {
+			return fmt.Errorf("failed to open cache: %w", err)
+		}
+
+		// If another process is using the cache the requests aren't cached and the cleanup is left for the next run.
+		s.file.db = db
+		s.file.opened = true
+
+		// The modification time shows when the file was last used so that it isn't removed as stale.
+		now := time.Now()
+		_ = os.Chtimes(s.path, now, now)
+	}
+
+	if s.file.db == nil || s.file.cleaned {
+		return nil
+	}
+
+	if err := s.file.db.Update(func(tx *bbolt.Tx) error {
+		if cleanup == CacheCleanupAll {
+			if err := tx.DeleteBucket([]byte(cacheBucket)); err != nil && !errors.Is(err, bbolterrors.ErrBucketNotFound) {
+				return fmt.Errorf("failed to clear cache: %w", err)
+			}
+		}
+
+		b, err := tx.CreateBucketIfNotExists([]byte(cacheBucket))
+		if err != nil {
+			return fmt.Errorf("failed to create cache bucket: %w", err)
+		}
+
+		if cleanup == CacheCleanupExpired {
+			if err := s.evict(b, maxSize); err != nil {
+				return fmt.Errorf("failed to clean up cache: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// bbolt doesn't shrink the file when entries are removed, so it's compacted to keep it under the maximum size.
+	if cleanup != CacheCleanupNone && maxSize > 0 {
+		if fi, err := os.Stat(s.path); err == nil && fi.Size() > maxSize {
+			if err := s.compact(); err != nil {
+				return fmt.Errorf("failed to compact cache: %w", err)
+			}
+		}
+	}
+
+	s.file.cleaned = true
+	return nil
+}
+
+// compact rewrites the cache file without its free pages, the file lock must be held.
+func (s *diskStorage) compact() error {
+	tmp := s.path + ".compact"
+	dst, err := bbolt.Open(tmp, 0o600, &bbolt.Options{Timeout: cacheLockTimeout})
+	if err != nil {
+		return err
+	}
+
+	if err := bbolt.Compact(dst, s.file.db, 0); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	if err := dst.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	if err := s.file.db.Close(); err != nil {
+		return err
+	}
+	s.file.db = nil
+
+	if err := os.Rename(tmp, s.path); err != nil {
+		_ = os.Remove(tmp)
+	}
+
+	db, err := bbolt.Open(s.path, 0o600, &bbolt.Options{Timeout: cacheLockTimeout})
+	if err != nil && !errors.Is(err, bbolterrors.ErrTimeout) {
+		return err
+	}
+	s.file.db = db
+
+	return nil
+}
+
+// sweepCacheDirectory removes the cache files in the directory, other than the current file, which haven't been used for the
+// maximum age. Files which are locked by another process are in use and are skipped.
+func sweepCacheDirectory(dir, current string, maxAge time.Duration, now time.Time) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.db"))
+	if err != nil {
+		return
+	}
+
+	for _, p := range paths {
+		if p == current {
+			continue
+		}
+
+		fi, err := os.Stat(p)
+		if err != nil || now.Sub(fi.ModTime()) <= maxAge {
+			continue
+		}
+
+		db, err := bbolt.Open(p, 0o600, &bbolt.Options{Timeout: cacheSweepLockTimeout, ReadOnly: true})
+		if errors.Is(err, bbolterrors.ErrTimeout) {
+			continue
+		}
+		if err == nil {
+			_ = db.Close()
+		}
+
+		_ = os.Remove(p)
+	}
+}
+
+// evict removes the expired entries from the bucket and then the oldest entries until the bucket is under the maximum size.
+func (s *diskStorage) evict(b *bbolt.Bucket, maxSize int64) error {
+	type entry struct {
+		key     []byte
+		size    int64
+		created time.Time
+	}
+
+	var entries []entry
+	var total int64
+	if err := b.ForEach(func(k, v []byte) error {
+		e := entry{key: slices.Clone(k), size: int64(len(k) + len(v))}
+		if len(v) >= cacheTimestampSize {
+			e.created = time.Unix(0, int64(binary.BigEndian.Uint64(v[:cacheTimestampSize])))
+		}
+		entries = append(entries, e)
+		total += e.size
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return a.created.Compare(b.created)
+	})
+
+	for _, e := range entries {
+		if !s.expired(e.created) && (maxSize <= 0 || total <= maxSize) {
+			break
+		}
+		if err := b.Delete(e.key); err != nil {
+			return err
+		}
+		total -= e.size
+	}
+
+	return nil
+}
+
+// expired returns true if an entry created at the given time can no longer be used.
+func (s *diskStorage) expired(created time.Time) bool {
+	return s.ttl > 0 && s.now().Sub(created) > s.ttl
+}
+
+// withDB calls fn with the database, or returns bbolt's timeout error if the cache file is held by another process.
+func (s *diskStorage) withDB(fn func(db *bbolt.DB) error) error {
+	s.file.mu.RLock()
+	defer s.file.mu.RUnlock()
+
+	if s.file.db == nil {
+		return bbolterrors.ErrTimeout
+	}
+
+	return fn(s.file.db)
+}
+
+// Get returns the cached response for the request, or nil if there isn't a usable response.
+func (s *diskStorage) Get(ctx context.Context, req *http.Request) (*http.Response, error) {
+	var data []byte
+	err := s.withDB(func(db *bbolt.DB) error {
+		return db.View(func(tx *bbolt.Tx) error {
+			b := tx.Bucket([]byte(cacheBucket))
+			if b == nil {
+				return nil
+			}
+
+			v := b.Get([]byte(req.URL.String()))
+			if len(v) < cacheTimestampSize {
+				return nil
+			}
+
+			created := time.Unix(0, int64(binary.BigEndian.Uint64(v[:cacheTimestampSize])))
+			if s.expired(created) {
+				return nil
+			}
+
+			data = slices.Clone(v[cacheTimestampSize:])
+			return nil
+		})
+	})
+	if err != nil {
+		// A cache that can't be read is treated as a miss so the request still succeeds.
+		if errors.Is(err, bbolterrors.ErrTimeout) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cache: %w", err)
+	}
+
+	if data == nil {
+		return nil, nil
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cached response: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Put stores the response in the cache.
+func (s *diskStorage) Put(ctx context.Context, resp *http.Response) error {
+	dump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return fmt.Errorf("failed to dump response: %w", err)
+	}
+
+	v := make([]byte, cacheTimestampSize, cacheTimestampSize+len(dump))
+	binary.BigEndian.PutUint64(v, uint64(s.now().UnixNano()))
+	v = append(v, dump...)
+
+	err = s.withDB(func(db *bbolt.DB) error {
+		return db.Update(func(tx *bbolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists([]byte(cacheBucket))
+			if err != nil {
+				return err
+			}
+			return b.Put([]byte(resp.Request.URL.String()), v)
+		})
+	})
+	if err != nil {
+		// Failing to cache a response shouldn't fail the request.
+		if errors.Is(err, bbolterrors.ErrTimeout) {
+			return nil
+		}
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+
+	return nil
+}
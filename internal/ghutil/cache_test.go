@@ -0,0 +1,321 @@
+package ghutil
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+func TestCacheTransport(t *testing.T) {
+	var requests, notModified atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Etag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = io.WriteString(w, `{"login":"octocat"}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	get := func(t *testing.T, tr http.RoundTripper) string {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/user", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := tr.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	newTransport := func(t *testing.T, identity string, opts *CacheOptions) http.RoundTripper {
+		t.Helper()
+
+		tr, err := cacheTransport(http.DefaultTransport, identity, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tr
+	}
+
+	t.Run("persistent", func(t *testing.T) {
+		requests.Store(0)
+		notModified.Store(0)
+		opts := &CacheOptions{Directory: t.TempDir(), Cleanup: CacheCleanupExpired}
+
+		if got := get(t, newTransport(t, "token:a", opts)); got != `{"login":"octocat"}` {
+			t.Fatalf("unexpected body %q", got)
+		}
+
+		// A new transport for the same identity simulates a later Terraform run.
+		if got := get(t, newTransport(t, "token:a", opts)); got != `{"login":"octocat"}` {
+			t.Fatalf("unexpected cached body %q", got)
+		}
+
+		if requests.Load() != 2 || notModified.Load() != 1 {
+			t.Fatalf("expected 2 requests with 1 not modified, got %d with %d", requests.Load(), notModified.Load())
+		}
+	})
+
+	t.Run("identities_isolated", func(t *testing.T) {
+		requests.Store(0)
+		notModified.Store(0)
+		opts := &CacheOptions{Directory: t.TempDir(), Cleanup: CacheCleanupExpired}
+
+		get(t, newTransport(t, tokenCacheIdentity(new(string)), opts))
+		get(t, newTransport(t, appCacheIdentity(1, 2, TokenScope{}), opts))
+		get(t, newTransport(t, appCacheIdentity(1, 2, TokenScope{Permissions: map[string]string{"contents": "read"}}), opts))
+
+		if notModified.Load() != 0 {
+			t.Fatalf("expected no cached responses to be shared, got %d", notModified.Load())
+		}
+	})
+
+	t.Run("cleanup_all", func(t *testing.T) {
+		requests.Store(0)
+		notModified.Store(0)
+		opts := &CacheOptions{Directory: t.TempDir(), Cleanup: CacheCleanupAll}
+
+		get(t, newTransport(t, "token:b", opts))
+		cacheFiles.Range(func(k, v any) bool {
+			v.(*cacheFile).cleaned = false
+			return true
+		})
+		get(t, newTransport(t, "token:b", opts))
+
+		if notModified.Load() != 0 {
+			t.Fatalf("expected the cache to be cleared, got %d cached responses", notModified.Load())
+		}
+	})
+}
+
+func TestDiskStorageExpiry(t *testing.T) {
+	s, err := openDiskStorage("token:c", &CacheOptions{Directory: t.TempDir(), TTL: time.Hour, Cleanup: CacheCleanupNone})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.github.com/user", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Etag": []string{`"v1"`}},
+		Body:       io.NopCloser(http.NoBody),
+		Request:    req,
+	}
+	if err := s.Put(t.Context(), resp); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		offset time.Duration
+		want   bool
+	}{
+		{name: "fresh", offset: time.Minute, want: true},
+		{name: "expired", offset: 2 * time.Hour, want: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s.now = func() time.Time { return now.Add(tc.offset) }
+
+			cached, err := s.Get(t.Context(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := cached != nil; got != tc.want {
+				t.Fatalf("expected cached response %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDiskStorageEvict(t *testing.T) {
+	dir := t.TempDir()
+	s, err := openDiskStorage("token:d", &CacheOptions{Directory: dir, Cleanup: CacheCleanupNone})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	var reqs []*http.Request
+	for i, u := range []string{"https://api.github.com/a", "https://api.github.com/b", "https://api.github.com/c"} {
+		s.now = func() time.Time { return now.Add(time.Duration(i) * time.Minute) }
+
+		req, err := http.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reqs = append(reqs, req)
+
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"Etag": []string{`"v1"`}},
+			Body:       io.NopCloser(http.NoBody),
+			Request:    req,
+		}
+		if err := s.Put(t.Context(), resp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// All of the entries are the same size so this only leaves room for the newest one.
+	var size int64
+	if err := s.withDB(func(db *bbolt.DB) error {
+		return db.View(func(tx *bbolt.Tx) error {
+			k, v := tx.Bucket([]byte(cacheBucket)).Cursor().First()
+			size = int64(len(k) + len(v))
+			return nil
+		})
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.file.cleaned = false
+	if err := s.init(CacheCleanupExpired, size); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, req := range reqs {
+		cached, err := s.Get(t.Context(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := i == len(reqs)-1; (cached != nil) != want {
+			t.Fatalf("expected %s cached %t, got %t", req.URL, want, cached != nil)
+		}
+	}
+}
+
+func TestDiskStorageCompact(t *testing.T) {
+	dir := t.TempDir()
+	s, err := openDiskStorage("token:e", &CacheOptions{Directory: dir, Cleanup: CacheCleanupNone})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := strings.Repeat("x", 64*1024)
+	for i := range 32 {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://api.github.com/%d", i), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := &http.Response{
+			StatusCode:    http.StatusOK,
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Etag": []string{`"v1"`}},
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}
+		if err := s.Put(t.Context(), resp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	before, err := os.Stat(s.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const maxSize = 256 * 1024
+	s.file.cleaned = false
+	if err := s.init(CacheCleanupExpired, maxSize); err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := os.Stat(s.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Size() >= before.Size() || after.Size() > 2*maxSize {
+		t.Fatalf("expected the cache file to be compacted from %d bytes to about %d bytes, got %d bytes", before.Size(), maxSize, after.Size())
+	}
+
+	// The storage must still be usable after the file has been replaced.
+	req, err := http.NewRequest(http.MethodGet, "https://api.github.com/31", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cached, err := s.Get(t.Context(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached == nil {
+		t.Fatal("expected the newest entry to still be cached")
+	}
+}
+
+func TestSweepCacheDirectory(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	create := func(name string, age time.Duration) string {
+		t.Helper()
+
+		p := filepath.Join(dir, name)
+		db, err := bbolt.Open(p, 0o600, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(p, now.Add(-age), now.Add(-age)); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	current := create("current.db", 48*time.Hour)
+	stale := create("stale.db", 48*time.Hour)
+	fresh := create("fresh.db", time.Hour)
+	locked := create("locked.db", 48*time.Hour)
+
+	db, err := bbolt.Open(locked, 0o600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	if err := os.Chtimes(locked, now.Add(-48*time.Hour), now.Add(-48*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	sweepCacheDirectory(dir, current, 24*time.Hour, now)
+
+	for p, want := range map[string]bool{current: true, stale: false, fresh: true, locked: true} {
+		if _, err := os.Stat(p); (err == nil) != want {
+			t.Fatalf("expected %s to exist %t, got error %v", filepath.Base(p), want, err)
+		}
+	}
+}
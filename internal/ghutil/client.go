@@ -3,19 +3,28 @@ package ghutil
 import (
 	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
+	"strings"
 
-	ghcht "github.com/bored-engineer/github-conditional-http-transport"
-	bboltstorage "github.com/bored-engineer/github-conditional-http-transport/bbolt"
 	"github.com/bradleyfalzon/ghinstallation/v2"
 	ratelimit "github.com/gofri/go-github-ratelimit/v2/github_ratelimit"
 	"github.com/google/go-github/v74/github"
 )
 
-// NewGitHubClient creates a new GitHub client with the given token and cache option.
-func NewGitHubClient(token *string, cache bool) (*github.Client, error) {
-	client, err := newGitHubClient(http.DefaultTransport, cache)
+// ClientOptions configures how GitHub clients make requests.
+type ClientOptions struct {
+	// BaseURL is the GitHub Enterprise Server API URL; if empty the public GitHub API is used.
+	BaseURL string
+	// UploadURL is the GitHub Enterprise Server upload URL; if empty BaseURL is used.
+	UploadURL string
+	// Cache configures the request cache; if nil requests aren't cached.
+	Cache *CacheOptions
+	// Retry configures request retries; if nil requests aren't retried.
+	Retry *RetryOptions
+}
+
+// NewGitHubClient creates a new GitHub client with the given token and options.
+func NewGitHubClient(token *string, opts ClientOptions) (*github.Client, error) {
+	client, err := newGitHubClient(http.DefaultTransport, tokenCacheIdentity(token), opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create github client: %w", err)
 	}
@@ -24,56 +33,91 @@ func NewGitHubClient(token *string, cache bool) (*github.Client, error) {
 		return client, nil
 	}
 
-	return client.WithAuthToken(*token), nil
+	// Copying the client doesn't preserve the rate limit check setting.
+	client = client.WithAuthToken(*token)
+	client.DisableRateLimitCheck = true
+
+	return client, nil
 }
 
-// NewGitHubClientForApp creates a new GitHub client for a GitHub App with the given credentials.
-func NewGitHubClientForApp(appID int64, privateKey []byte, installationID int64, cache bool) (*github.Client, error) {
-	tr := http.DefaultTransport
+// NewGitHubClientForApp creates a new GitHub client for a GitHub App with the given credentials, installation tokens are limited
+// to the scope; the scope is ignored for the app itself.
+func NewGitHubClientForApp(appID int64, key AppKey, installationID int64, scope TokenScope, opts ClientOptions) (*github.Client, error) {
+	signer, err := key.signer()
+	if err != nil {
+		return nil, fmt.Errorf("invalid app key: %w", err)
+	}
 
-	atr, err := ghinstallation.NewAppsTransport(tr, appID, privateKey)
+	atr, err := ghinstallation.NewAppsTransportWithOptions(http.DefaultTransport, appID, ghinstallation.WithSigner(appJWTSigner{signer: signer}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create app transport: %w", err)
 	}
 
-	if installationID != -1 {
-		tr = ghinstallation.NewFromAppsTransport(atr, installationID)
-	} else {
-		tr = atr
+	if len(opts.BaseURL) != 0 {
+		c, err := withEnterpriseURLs(github.NewClient(nil), opts)
+		if err != nil {
+			return nil, err
+		}
+		atr.BaseURL = strings.TrimSuffix(c.BaseURL.String(), "/")
+	}
+
+	if installationID == -1 {
+		return newGitHubClient(atr, appCacheIdentity(appID, installationID, TokenScope{}), opts)
 	}
 
-	return newGitHubClient(tr, cache)
+	tokenOpts, err := scope.installationTokenOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid token scope: %w", err)
+	}
+
+	itr := ghinstallation.NewFromAppsTransport(atr, installationID)
+	itr.InstallationTokenOptions = tokenOpts
+
+	return newGitHubClient(itr, appCacheIdentity(appID, installationID, scope), opts)
 }
 
-// newGitHubClient creates a new GitHub client with the given transport and cache option.
-func newGitHubClient(tr http.RoundTripper, cache bool) (*github.Client, error) {
+// newGitHubClient creates a new GitHub client with the given transport and options, the cache is keyed by the identity so responses
+// are never shared between credentials.
+func newGitHubClient(tr http.RoundTripper, identity string, opts ClientOptions) (*github.Client, error) {
 	tr = ratelimit.New(tr)
 
-	if cache {
-		ctr, err := cacheTransport(tr)
+	if opts.Retry != nil {
+		tr = newRetryTransport(tr, opts.Retry)
+	}
+
+	if opts.Cache != nil {
+		ctr, err := cacheTransport(tr, identity, opts.Cache)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create cache transport: %w", err)
 		}
 		tr = ctr
 	}
 
-	client := github.NewClient(&http.Client{Transport: tr})
+	client, err := withEnterpriseURLs(github.NewClient(&http.Client{Transport: tr}), opts)
+	if err != nil {
+		return nil, err
+	}
 	client.DisableRateLimitCheck = true
 
 	return client, nil
 }
 
-// cacheTransport creates a new http.RoundTripper with a cache.
-func cacheTransport(tr http.RoundTripper) (http.RoundTripper, error) {
-	dir, err := os.MkdirTemp("", "terraform-provider-github")
-	if err != nil {
-		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+// withEnterpriseURLs returns a copy of the client using the GitHub Enterprise Server URLs from the options, or the client if they
+// aren't set; the copy doesn't preserve the rate limit check setting.
+func withEnterpriseURLs(client *github.Client, opts ClientOptions) (*github.Client, error) {
+	if len(opts.BaseURL) == 0 {
+		return client, nil
+	}
+
+	uploadURL := opts.UploadURL
+	if len(uploadURL) == 0 {
+		uploadURL = opts.BaseURL
 	}
 
-	stor, err := bboltstorage.Open(filepath.Join(dir, "cache.db"), 0o644, nil, nil)
+	c, err := client.WithEnterpriseURLs(opts.BaseURL, uploadURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open cache: %w", err)
+		return nil, fmt.Errorf("failed to set enterprise URLs: %w", err)
 	}
 
-	return ghcht.NewTransport(stor, tr), nil
+	return c, nil
 }
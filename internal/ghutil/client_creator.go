@@ -12,20 +12,21 @@ type ClientCreator interface {
 	AppClient() (*github.Client, error)
 	DefaultClient(ctx context.Context) (*github.Client, error)
 	OrganizationClient(ctx context.Context, organization string) (*github.Client, error)
+	ScopedOrganizationClient(ctx context.Context, organization string, scope TokenScope) (*github.Client, error)
 }
 
 // clientCreator is responsible for creating GitHub clients using optional token authentication.
 type clientCreator struct {
-	token         *string
-	cacheRequests bool
-	client        *github.Client
+	token  *string
+	opts   ClientOptions
+	client *github.Client
 }
 
 // NewClientCreator creates a ClientCreator that can optionally authenticate using a token.
-func NewClientCreator(token *string, cacheRequests bool) (ClientCreator, error) {
+func NewClientCreator(token *string, opts ClientOptions) (ClientCreator, error) {
 	cc := &clientCreator{
-		token:         token,
-		cacheRequests: cacheRequests,
+		token: token,
+		opts:  opts,
 	}
 
 	return cc, nil
@@ -42,7 +43,7 @@ func (cc *clientCreator) DefaultClient(ctx context.Context) (*github.Client, err
 		return cc.client, nil
 	}
 
-	c, err := NewGitHubClient(cc.token, cc.cacheRequests)
+	c, err := NewGitHubClient(cc.token, cc.opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create github client: %w", err)
 	}
@@ -55,3 +56,8 @@ func (cc *clientCreator) DefaultClient(ctx context.Context) (*github.Client, err
 func (cc *clientCreator) OrganizationClient(ctx context.Context, organization string) (*github.Client, error) {
 	return cc.DefaultClient(ctx)
 }
+
+// ScopedOrganizationClient returns a GitHub client for an organization; tokens can't be limited so the scope is ignored.
+func (cc *clientCreator) ScopedOrganizationClient(ctx context.Context, organization string, scope TokenScope) (*github.Client, error) {
+	return cc.DefaultClient(ctx)
+}
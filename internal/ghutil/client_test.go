@@ -0,0 +1,97 @@
+package ghutil
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestEnterpriseURLs(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	var mu sync.Mutex
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v3/app/installations/2/access_tokens":
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"token": "installation-token", "expires_at": time.Now().Add(time.Hour)})
+		case "/api/v3/user":
+			_ = json.NewEncoder(w).Encode(map[string]any{"login": "octocat"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	opts := ClientOptions{BaseURL: srv.URL}
+
+	for _, tc := range []struct {
+		name      string
+		newClient func() (*github.Client, error)
+		wantPaths []string
+	}{
+		{
+			name: "token",
+			newClient: func() (*github.Client, error) {
+				return NewGitHubClient(github.Ptr("token"), opts)
+			},
+			wantPaths: []string{"/api/v3/user"},
+		},
+		{
+			name: "app",
+			newClient: func() (*github.Client, error) {
+				return NewGitHubClientForApp(1, AppKey{PEM: privateKey}, 2, TokenScope{}, opts)
+			},
+			wantPaths: []string{"/api/v3/app/installations/2/access_tokens", "/api/v3/user"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mu.Lock()
+			paths = nil
+			mu.Unlock()
+
+			client, err := tc.newClient()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := client.UploadURL.String(), srv.URL+"/api/uploads/"; got != want {
+				t.Fatalf("expected upload URL %q, got %q", want, got)
+			}
+
+			user, _, err := client.Users.Get(t.Context(), "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user.GetLogin() != "octocat" {
+				t.Fatalf("unexpected user %q", user.GetLogin())
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !slices.Equal(paths, tc.wantPaths) {
+				t.Fatalf("expected requests %v, got %v", tc.wantPaths, paths)
+			}
+		})
+	}
+}
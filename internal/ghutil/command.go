@@ -0,0 +1,40 @@
+package ghutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// commandWaitDelay is how long to wait for the output of a command to be closed after it's stopped.
+const commandWaitDelay = time.Second
+
+// runCommand runs the command, which is the executable followed by its arguments, with the input on standard input and returns
+// its standard output; standard error is included in the error if the command fails.
+func runCommand(ctx context.Context, args []string, input []byte) ([]byte, error) {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return nil, fmt.Errorf("command is empty")
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = &stderr
+	// Processes started by the command can keep its output open after it's stopped, so don't wait for them.
+	cmd.WaitDelay = commandWaitDelay
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); len(msg) != 0 {
+			return nil, fmt.Errorf("command %q failed: %w: %s", args[0], err, msg)
+		}
+		return nil, fmt.Errorf("command %q failed: %w", args[0], err)
+	}
+
+	return out, nil
+}
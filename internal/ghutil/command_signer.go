@@ -0,0 +1,64 @@
+package ghutil
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// commandSignerTimeout is how long the signing command can run before it's stopped, so a command waiting for input such as an
+// interactive login doesn't block the provider.
+const commandSignerTimeout = 30 * time.Second
+
+// commandSigner is a crypto.Signer which signs digests by running a command, so that the private key can be kept in an external
+// system such as a KMS.
+type commandSigner struct {
+	args    []string
+	timeout time.Duration
+}
+
+// NewCommandSigner returns a crypto.Signer which runs the command, which is the executable followed by its arguments, to sign
+// each SHA-256 digest. The base64 encoded digest is written to the command's standard input and the command must write the base64
+// encoded RSASSA-PKCS1-v1_5 signature to its standard output. The signer doesn't know the public key so Public returns nil.
+func NewCommandSigner(args []string) (crypto.Signer, error) {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return nil, fmt.Errorf("signing command is empty")
+	}
+
+	return &commandSigner{args: args, timeout: commandSignerTimeout}, nil
+}
+
+// Public returns nil as the public key isn't known.
+func (s *commandSigner) Public() crypto.PublicKey {
+	return nil
+}
+
+// Sign signs the digest by running the command, which is stopped if it doesn't finish within the timeout.
+func (s *commandSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != crypto.SHA256 {
+		return nil, fmt.Errorf("unsupported hash function %s, only SHA-256 is supported", opts.HashFunc())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	out, err := runCommand(ctx, s.args, []byte(base64.StdEncoding.EncodeToString(digest)))
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("command %q didn't finish within %s", s.args[0], s.timeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(string(bytes.Join(bytes.Fields(out), nil)))
+	if err != nil || len(sig) == 0 {
+		return nil, fmt.Errorf("command %q didn't output a base64 encoded signature", s.args[0])
+	}
+
+	return sig, nil
+}
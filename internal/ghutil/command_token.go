@@ -0,0 +1,63 @@
+package ghutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// NewCommandClientCreator creates a ClientCreator that authenticates using tokens written to standard output by a command, which
+// is the executable followed by its arguments. The output is either a JSON object with a "token" and an optional "expires_at" in
+// RFC 3339 format, or the token on its own; the command is run again before the token expires.
+func NewCommandClientCreator(args []string, opts ClientOptions) (ClientCreator, error) {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return nil, fmt.Errorf("token command is empty")
+	}
+
+	return newTokenSourceClientCreator(commandTokenSource(args), commandCacheIdentity(args), opts), nil
+}
+
+// commandTokenSource returns a token source which runs the command for each token.
+func commandTokenSource(args []string) TokenSource {
+	return func(ctx context.Context) (Token, error) {
+		out, err := runCommand(ctx, args, nil)
+		if err != nil {
+			return Token{}, err
+		}
+
+		token, err := parseCommandToken(out)
+		if err != nil {
+			return Token{}, fmt.Errorf("invalid output from command %q: %w", args[0], err)
+		}
+
+		return token, nil
+	}
+}
+
+// parseCommandToken parses the output of a token command.
+func parseCommandToken(out []byte) (Token, error) {
+	out = bytes.TrimSpace(out)
+	if len(out) == 0 {
+		return Token{}, fmt.Errorf("no token")
+	}
+
+	if out[0] != '{' {
+		return Token{Value: string(out)}, nil
+	}
+
+	var body struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.Unmarshal(out, &body); err != nil {
+		return Token{}, err
+	}
+
+	if len(body.Token) == 0 {
+		return Token{}, fmt.Errorf("no token")
+	}
+
+	return Token{Value: body.Token, ExpiresAt: body.ExpiresAt}, nil
+}
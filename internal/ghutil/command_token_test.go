@@ -0,0 +1,85 @@
+package ghutil
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCommandToken(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		out           string
+		wantToken     string
+		wantExpiresAt time.Time
+		wantErr       string
+	}{
+		{
+			name:      "plain",
+			out:       "gho_token\n",
+			wantToken: "gho_token",
+		},
+		{
+			name:      "json",
+			out:       `{"token":"ghs_token"}`,
+			wantToken: "ghs_token",
+		},
+		{
+			name:          "json_expiry",
+			out:           `{"token":"ghs_token","expires_at":"2030-01-02T03:04:05Z"}`,
+			wantToken:     "ghs_token",
+			wantExpiresAt: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name:    "empty",
+			out:     " \n",
+			wantErr: "no token",
+		},
+		{
+			name:    "json_no_token",
+			out:     `{"expires_at":"2030-01-02T03:04:05Z"}`,
+			wantErr: "no token",
+		},
+		{
+			name:    "json_invalid_expiry",
+			out:     `{"token":"ghs_token","expires_at":"tomorrow"}`,
+			wantErr: "cannot parse",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			token, err := parseCommandToken([]byte(tc.out))
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if token.Value != tc.wantToken || !token.ExpiresAt.Equal(tc.wantExpiresAt) {
+				t.Errorf("expected token %q expiring %v, got %q expiring %v", tc.wantToken, tc.wantExpiresAt, token.Value, token.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	token, err := commandTokenSource([]string{"echo", `{"token":"ghs_token"}`})(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Value != "ghs_token" {
+		t.Errorf("expected token %q, got %q", "ghs_token", token.Value)
+	}
+
+	if _, err := commandTokenSource([]string{"sh", "-c", "echo denied >&2; exit 1"})(t.Context()); err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("expected the command error output, got: %v", err)
+	}
+
+	if _, err := NewCommandClientCreator(nil, ClientOptions{}); err == nil {
+		t.Error("expected an empty command error")
+	}
+}
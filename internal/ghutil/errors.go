@@ -0,0 +1,20 @@
+package ghutil
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// IsNotFound returns true if the error is a GitHub API 404 response.
+func IsNotFound(err error) bool {
+	var errResp *github.ErrorResponse
+	return errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound
+}
+
+// IsConflict returns true if the error is a GitHub API 409 response.
+func IsConflict(err error) bool {
+	var errResp *github.ErrorResponse
+	return errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusConflict
+}
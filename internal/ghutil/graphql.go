@@ -0,0 +1,82 @@
+package ghutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// GraphQLError is a GraphQL API response containing errors, the API returns a "200 OK" status with the errors in the body.
+type GraphQLError struct {
+	Errors []GraphQLErrorItem
+}
+
+// GraphQLErrorItem is a single error returned by the GraphQL API.
+type GraphQLErrorItem struct {
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message"`
+}
+
+// Error returns the messages of the errors.
+func (e *GraphQLError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, item := range e.Errors {
+		messages = append(messages, item.Message)
+	}
+
+	return fmt.Sprintf("graphql: %s", strings.Join(messages, "; "))
+}
+
+// GraphQL executes a GraphQL query or mutation with the client and decodes the data of the response into data. The GraphQL
+// endpoint is derived from the client base URL, which is "/api/graphql" for GitHub Enterprise Server and "/graphql" otherwise.
+func GraphQL(ctx context.Context, client *github.Client, query string, variables map[string]any, data any) error {
+	u, err := GraphQLURL(client.BaseURL)
+	if err != nil {
+		return err
+	}
+
+	req, err := client.NewRequest(http.MethodPost, u.String(), map[string]any{"query": query, "variables": variables})
+	if err != nil {
+		return err
+	}
+
+	var body struct {
+		Data   json.RawMessage    `json:"data"`
+		Errors []GraphQLErrorItem `json:"errors"`
+	}
+	if _, err := client.Do(ctx, req, &body); err != nil {
+		return err
+	}
+
+	if len(body.Errors) != 0 {
+		return &GraphQLError{Errors: body.Errors}
+	}
+
+	if data == nil || len(body.Data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body.Data, data)
+}
+
+// GraphQLURL returns the GraphQL endpoint for a REST API base URL.
+func GraphQLURL(baseURL *url.URL) (*url.URL, error) {
+	if baseURL == nil {
+		return nil, errors.New("base URL is required")
+	}
+
+	u := *baseURL
+	if prefix, ok := strings.CutSuffix(strings.TrimSuffix(u.Path, "/"), "/api/v3"); ok {
+		u.Path = prefix + "/api/graphql"
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/graphql"
+	}
+
+	return &u, nil
+}
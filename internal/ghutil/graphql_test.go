@@ -0,0 +1,90 @@
+package ghutil
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestGraphQLURL(t *testing.T) {
+	for _, tc := range []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: "https://api.github.com/", want: "https://api.github.com/graphql"},
+		{baseURL: "https://github.example.com/api/v3/", want: "https://github.example.com/api/graphql"},
+		{baseURL: "https://example.com/github/api/v3/", want: "https://example.com/github/api/graphql"},
+	} {
+		t.Run(tc.baseURL, func(t *testing.T) {
+			baseURL, err := url.Parse(tc.baseURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := GraphQLURL(baseURL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.String() != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got.String())
+			}
+		})
+	}
+}
+
+func TestGraphQL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/graphql" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if body.Variables["login"] != "octocat" {
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a User."}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"user":{"name":"The Octocat"}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewGitHubClient(github.Ptr("test"), ClientOptions{BaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const query = `query User($login: String!) { user(login: $login) { name } }`
+
+	var data struct {
+		User struct {
+			Name string `json:"name"`
+		} `json:"user"`
+	}
+	if err := GraphQL(t.Context(), client, query, map[string]any{"login": "octocat"}, &data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.User.Name != "The Octocat" {
+		t.Fatalf("expected name %q, got %q", "The Octocat", data.User.Name)
+	}
+
+	err = GraphQL(t.Context(), client, query, map[string]any{"login": "unknown"}, &data)
+	var gqlErr *GraphQLError
+	if !errors.As(err, &gqlErr) || len(gqlErr.Errors) != 1 || gqlErr.Errors[0].Type != "NOT_FOUND" {
+		t.Fatalf("expected a GraphQL error, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+package ghutil
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SplitIdentifier splits an identifier such as an import ID into exactly one part per name, each of which must be non-empty. The
+// names are used in the errors to describe the expected format.
+func SplitIdentifier(id, sep string, names ...string) ([]string, error) {
+	parts := strings.Split(id, sep)
+	if len(parts) != len(names) {
+		return nil, fmt.Errorf("%q must be in the format %q", id, strings.Join(names, sep))
+	}
+
+	for i, part := range parts {
+		if len(part) == 0 {
+			return nil, fmt.Errorf("%s must be non-empty", names[i])
+		}
+	}
+
+	return parts, nil
+}
+
+// ParseRepository parses a repository full name in the format "owner/name" into the owner and the name.
+func ParseRepository(fullName string) (string, string, error) {
+	parts, err := SplitIdentifier(fullName, "/", "owner", "name")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// ParseTeam parses a team identifier into the organization and the team slug, both the provider format "organization:team_slug"
+// and the GitHub mention format "@organization/team_slug" are supported.
+func ParseTeam(id string) (string, string, error) {
+	if mention, ok := strings.CutPrefix(id, "@"); ok {
+		parts, err := SplitIdentifier(mention, "/", "organization", "team_slug")
+		if err != nil {
+			return "", "", err
+		}
+		return parts[0], parts[1], nil
+	}
+
+	parts, err := SplitIdentifier(id, ":", "organization", "team_slug")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// RefToBranch returns the branch name of a git ref, a fully qualified branch ref such as "refs/heads/main" has the prefix removed
+// and a short name is returned unchanged. Other fully qualified refs, such as tags and pull request refs, aren't branches.
+func RefToBranch(ref string) (string, error) {
+	if branch, ok := strings.CutPrefix(ref, "refs/heads/"); ok {
+		if len(branch) == 0 {
+			return "", fmt.Errorf("branch must be non-empty")
+		}
+		return branch, nil
+	}
+
+	if len(ref) == 0 {
+		return "", fmt.Errorf("ref must be non-empty")
+	}
+
+	if strings.HasPrefix(ref, "refs/") {
+		return "", fmt.Errorf("ref %q isn't a branch", ref)
+	}
+
+	return ref, nil
+}
+
+// GitHubURL is a parsed GitHub URL, the fields which aren't part of the URL are empty.
+type GitHubURL struct {
+	// Host is the host name, such as "github.com" or a GitHub Enterprise Server host.
+	Host string
+	// Owner is the login of the user or organization.
+	Owner string
+	// Repository is the name of the repository, without a ".git" suffix.
+	Repository string
+	// Ref is the git ref of a tree, blob or commit URL; as a branch name can contain "/" only the first path segment is used.
+	Ref string
+	// Path is the path of the file or directory in a tree or blob URL.
+	Path string
+	// Number is the number of a pull request or issue URL.
+	Number int
+}
+
+// ParseURL parses a GitHub web or git URL. HTTPS URLs of an owner, a repository, a tree, blob or commit, or a pull request or
+// issue are supported, as are SSH git URLs in both the "git@host:owner/repo.git" and "ssh://git@host/owner/repo.git" formats.
+func ParseURL(raw string) (GitHubURL, error) {
+	if rest, ok := strings.CutPrefix(raw, "git@"); ok {
+		host, p, ok := strings.Cut(rest, ":")
+		if !ok || len(host) == 0 {
+			return GitHubURL{}, fmt.Errorf("invalid git URL %q", raw)
+		}
+		return parseURLPath(raw, host, p, true)
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return GitHubURL{}, fmt.Errorf("invalid URL %q: %w", raw, err)
+	}
+
+	switch u.Scheme {
+	case "https", "http":
+		return parseURLPath(raw, u.Hostname(), u.Path, false)
+	case "ssh":
+		return parseURLPath(raw, u.Hostname(), u.Path, true)
+	default:
+		return GitHubURL{}, fmt.Errorf("unsupported URL %q, must be an HTTPS or SSH URL", raw)
+	}
+}
+
+// parseURLPath parses the path of a GitHub URL, a git URL must reference a repository.
+func parseURLPath(raw, host, p string, git bool) (GitHubURL, error) {
+	if len(host) == 0 {
+		return GitHubURL{}, fmt.Errorf("URL %q doesn't have a host", raw)
+	}
+
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	if len(segments[0]) == 0 {
+		return GitHubURL{}, fmt.Errorf("URL %q doesn't reference an owner", raw)
+	}
+
+	parsed := GitHubURL{Host: host, Owner: segments[0]}
+	if len(segments) == 1 {
+		if git {
+			return GitHubURL{}, fmt.Errorf("git URL %q doesn't reference a repository", raw)
+		}
+		return parsed, nil
+	}
+
+	parsed.Repository = strings.TrimSuffix(segments[1], ".git")
+	if len(parsed.Repository) == 0 {
+		return GitHubURL{}, fmt.Errorf("URL %q doesn't reference a repository", raw)
+	}
+
+	rest := segments[2:]
+	if len(rest) == 0 {
+		return parsed, nil
+	}
+	if git {
+		return GitHubURL{}, fmt.Errorf("git URL %q must only reference a repository", raw)
+	}
+
+	switch rest[0] {
+	case "tree", "blob", "commit":
+		if len(rest) < 2 || len(rest[1]) == 0 {
+			return GitHubURL{}, fmt.Errorf("URL %q doesn't reference a ref", raw)
+		}
+		parsed.Ref = rest[1]
+		parsed.Path = strings.Join(rest[2:], "/")
+	case "pull", "issues":
+		if len(rest) < 2 {
+			return GitHubURL{}, fmt.Errorf("URL %q doesn't reference a number", raw)
+		}
+		n, err := strconv.Atoi(rest[1])
+		if err != nil || n <= 0 {
+			return GitHubURL{}, fmt.Errorf("URL %q doesn't reference a valid number", raw)
+		}
+		parsed.Number = n
+	}
+
+	return parsed, nil
+}
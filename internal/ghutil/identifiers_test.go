@@ -0,0 +1,191 @@
+package ghutil
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitIdentifier(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		id      string
+		names   []string
+		want    []string
+		wantErr string
+	}{
+		{name: "valid", id: "org:team", names: []string{"organization", "team_slug"}, want: []string{"org", "team"}},
+		{name: "three_parts", id: "org:team:user", names: []string{"organization", "team_slug", "username"}, want: []string{"org", "team", "user"}},
+		{name: "too_few_parts", id: "org", names: []string{"organization", "team_slug"}, wantErr: `must be in the format "organization:team_slug"`},
+		{name: "too_many_parts", id: "org:team:user", names: []string{"organization", "team_slug"}, wantErr: `must be in the format "organization:team_slug"`},
+		{name: "empty_first", id: ":team", names: []string{"organization", "team_slug"}, wantErr: "organization must be non-empty"},
+		{name: "empty_last", id: "org:", names: []string{"organization", "team_slug"}, wantErr: "team_slug must be non-empty"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := SplitIdentifier(tc.id, ":", tc.names...)
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseRepository(t *testing.T) {
+	for _, tc := range []struct {
+		fullName  string
+		wantOwner string
+		wantName  string
+		wantErr   string
+	}{
+		{fullName: "octocat/hello-world", wantOwner: "octocat", wantName: "hello-world"},
+		{fullName: "octocat", wantErr: `must be in the format "owner/name"`},
+		{fullName: "octocat/hello-world/extra", wantErr: `must be in the format "owner/name"`},
+		{fullName: "/hello-world", wantErr: "owner must be non-empty"},
+		{fullName: "octocat/", wantErr: "name must be non-empty"},
+	} {
+		t.Run(tc.fullName, func(t *testing.T) {
+			owner, name, err := ParseRepository(tc.fullName)
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if owner != tc.wantOwner || name != tc.wantName {
+				t.Fatalf("expected %q and %q, got %q and %q", tc.wantOwner, tc.wantName, owner, name)
+			}
+		})
+	}
+}
+
+func TestParseTeam(t *testing.T) {
+	for _, tc := range []struct {
+		id               string
+		wantOrganization string
+		wantSlug         string
+		wantErr          string
+	}{
+		{id: "octo-org:octo-team", wantOrganization: "octo-org", wantSlug: "octo-team"},
+		{id: "@octo-org/octo-team", wantOrganization: "octo-org", wantSlug: "octo-team"},
+		{id: "octo-org/octo-team", wantErr: `must be in the format "organization:team_slug"`},
+		{id: "@octo-org:octo-team", wantErr: `must be in the format "organization/team_slug"`},
+		{id: "octo-org:", wantErr: "team_slug must be non-empty"},
+		{id: "@/octo-team", wantErr: "organization must be non-empty"},
+	} {
+		t.Run(tc.id, func(t *testing.T) {
+			organization, slug, err := ParseTeam(tc.id)
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if organization != tc.wantOrganization || slug != tc.wantSlug {
+				t.Fatalf("expected %q and %q, got %q and %q", tc.wantOrganization, tc.wantSlug, organization, slug)
+			}
+		})
+	}
+}
+
+func TestRefToBranch(t *testing.T) {
+	for _, tc := range []struct {
+		ref     string
+		want    string
+		wantErr string
+	}{
+		{ref: "refs/heads/main", want: "main"},
+		{ref: "refs/heads/feature/new", want: "feature/new"},
+		{ref: "main", want: "main"},
+		{ref: "feature/new", want: "feature/new"},
+		{ref: "refs/tags/v1.0.0", wantErr: "isn't a branch"},
+		{ref: "refs/pull/1/merge", wantErr: "isn't a branch"},
+		{ref: "refs/heads/", wantErr: "branch must be non-empty"},
+		{ref: "", wantErr: "ref must be non-empty"},
+	} {
+		t.Run(tc.ref, func(t *testing.T) {
+			got, err := RefToBranch(tc.ref)
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseURL(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		url     string
+		want    GitHubURL
+		wantErr string
+	}{
+		{name: "owner", url: "https://github.com/octocat", want: GitHubURL{Host: "github.com", Owner: "octocat"}},
+		{name: "repository", url: "https://github.com/octocat/hello-world", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world"}},
+		{name: "repository_git", url: "https://github.com/octocat/hello-world.git", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world"}},
+		{name: "trailing_slash", url: "https://github.com/octocat/hello-world/", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world"}},
+		{name: "enterprise", url: "https://github.example.com/octocat/hello-world", want: GitHubURL{Host: "github.example.com", Owner: "octocat", Repository: "hello-world"}},
+		{name: "tree", url: "https://github.com/octocat/hello-world/tree/main", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world", Ref: "main"}},
+		{name: "blob", url: "https://github.com/octocat/hello-world/blob/main/docs/README.md", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world", Ref: "main", Path: "docs/README.md"}},
+		{name: "commit", url: "https://github.com/octocat/hello-world/commit/7fd1a60", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world", Ref: "7fd1a60"}},
+		{name: "pull", url: "https://github.com/octocat/hello-world/pull/42", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world", Number: 42}},
+		{name: "pull_files", url: "https://github.com/octocat/hello-world/pull/42/files", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world", Number: 42}},
+		{name: "issue", url: "https://github.com/octocat/hello-world/issues/7", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world", Number: 7}},
+		{name: "scp", url: "git@github.com:octocat/hello-world.git", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world"}},
+		{name: "ssh", url: "ssh://git@github.com/octocat/hello-world.git", want: GitHubURL{Host: "github.com", Owner: "octocat", Repository: "hello-world"}},
+		{name: "unsupported_scheme", url: "ftp://github.com/octocat", wantErr: "unsupported URL"},
+		{name: "no_owner", url: "https://github.com/", wantErr: "doesn't reference an owner"},
+		{name: "scp_owner_only", url: "git@github.com:octocat", wantErr: "doesn't reference a repository"},
+		{name: "scp_no_host", url: "git@:octocat/hello-world.git", wantErr: "invalid git URL"},
+		{name: "tree_no_ref", url: "https://github.com/octocat/hello-world/tree", wantErr: "doesn't reference a ref"},
+		{name: "pull_invalid_number", url: "https://github.com/octocat/hello-world/pull/abc", wantErr: "doesn't reference a valid number"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseURL(tc.url)
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package ghutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// exchangedTokenLifetime is the assumed lifetime of an exchanged token if the exchange service doesn't return an expiry, which is
+// the lifetime of a GitHub app installation token.
+const exchangedTokenLifetime = time.Hour
+
+// OIDCOptions configures exchanging an OIDC ID token for a GitHub token.
+type OIDCOptions struct {
+	// ExchangeURL is the URL of the token exchange service; the ID token is sent as a bearer token in a GET request and the
+	// response must be a JSON object with a "token" and an optional "expires_at".
+	ExchangeURL string
+	// Audience is the audience requested for the GitHub Actions ID token; if empty the exchange URL host is used.
+	Audience string
+	// IDTokenCommand is the executable followed by its arguments which writes an ID token to standard output; if empty the
+	// GitHub Actions ID token is requested.
+	IDTokenCommand []string
+}
+
+// NewOIDCClientCreator creates a ClientCreator that authenticates using GitHub tokens exchanged for OIDC ID tokens, such as the
+// GitHub Actions workload identity token. A new token is exchanged before the current one expires.
+func NewOIDCClientCreator(oidc OIDCOptions, opts ClientOptions) (ClientCreator, error) {
+	u, err := url.Parse(oidc.ExchangeURL)
+	if err != nil || !u.IsAbs() || len(u.Host) == 0 {
+		return nil, fmt.Errorf("invalid token exchange URL %q", oidc.ExchangeURL)
+	}
+
+	if len(oidc.Audience) == 0 {
+		oidc.Audience = u.Host
+	}
+
+	return newTokenSourceClientCreator(oidcTokenSource(oidc), oidcCacheIdentity(oidc.ExchangeURL), opts), nil
+}
+
+// oidcTokenSource returns a token source which exchanges a new ID token for each GitHub token.
+func oidcTokenSource(oidc OIDCOptions) TokenSource {
+	return func(ctx context.Context) (Token, error) {
+		idToken, err := oidcIDToken(ctx, oidc)
+		if err != nil {
+			return Token{}, fmt.Errorf("failed to get ID token: %w", err)
+		}
+
+		token, err := exchangeIDToken(ctx, oidc.ExchangeURL, idToken)
+		if err != nil {
+			return Token{}, fmt.Errorf("failed to exchange ID token: %w", err)
+		}
+
+		return token, nil
+	}
+}
+
+// oidcIDToken returns an ID token from the command if there is one, otherwise from GitHub Actions.
+func oidcIDToken(ctx context.Context, oidc OIDCOptions) (string, error) {
+	if len(oidc.IDTokenCommand) == 0 {
+		return actionsIDToken(ctx, oidc.Audience)
+	}
+
+	out, err := runCommand(ctx, oidc.IDTokenCommand, nil)
+	if err != nil {
+		return "", err
+	}
+
+	idToken := strings.TrimSpace(string(out))
+	if len(idToken) == 0 {
+		return "", fmt.Errorf("command %q didn't output an ID token", oidc.IDTokenCommand[0])
+	}
+
+	return idToken, nil
+}
+
+// actionsIDToken requests a GitHub Actions ID token for the audience, which requires the workflow to have the "id-token: write"
+// permission.
+func actionsIDToken(ctx context.Context, audience string) (string, error) {
+	requestURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if len(requestURL) == 0 || len(requestToken) == 0 {
+		return "", fmt.Errorf("ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN aren't set; the workflow needs the \"id-token: write\" permission")
+	}
+
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid ACTIONS_ID_TOKEN_REQUEST_URL: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("audience", audience)
+	u.RawQuery = q.Encode()
+
+	var body struct {
+		Value string `json:"value"`
+	}
+	if err := getJSON(ctx, u.String(), requestToken, &body); err != nil {
+		return "", err
+	}
+
+	if len(body.Value) == 0 {
+		return "", fmt.Errorf("GitHub Actions didn't return an ID token")
+	}
+
+	return body.Value, nil
+}
+
+// exchangeIDToken exchanges the ID token for a GitHub token.
+func exchangeIDToken(ctx context.Context, exchangeURL, idToken string) (Token, error) {
+	var body struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := getJSON(ctx, exchangeURL, idToken, &body); err != nil {
+		return Token{}, err
+	}
+
+	if len(body.Token) == 0 {
+		return Token{}, fmt.Errorf("token exchange didn't return a token")
+	}
+
+	expiresAt := body.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(exchangedTokenLifetime)
+	}
+
+	return Token{Value: body.Token, ExpiresAt: expiresAt}, nil
+}
+
+// getJSON sends a GET request authenticated with the bearer token and decodes the JSON response into v.
+func getJSON(ctx context.Context, u, bearer string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", bearer))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d from %s: %s", resp.StatusCode, req.URL.Redacted(), strings.TrimSpace(string(msg)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", req.URL.Redacted(), err)
+	}
+
+	return nil
+}
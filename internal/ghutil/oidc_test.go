@@ -0,0 +1,121 @@
+package ghutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOIDCTokenSource(t *testing.T) {
+	expiresAt := time.Now().Add(30 * time.Minute).UTC().Truncate(time.Second)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/actions":
+			if r.Header.Get("Authorization") != "Bearer request-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"value": "actions-" + r.URL.Query().Get("audience")})
+		case "/exchange":
+			_ = json.NewEncoder(w).Encode(map[string]any{"token": "ghs_" + strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), "expires_at": expiresAt})
+		case "/exchange-no-expiry":
+			_ = json.NewEncoder(w).Encode(map[string]any{"token": "ghs_token"})
+		case "/exchange-denied":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"no trust policy"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, tc := range []struct {
+		name          string
+		oidc          OIDCOptions
+		env           bool
+		wantToken     string
+		wantExpiresAt time.Time
+		wantErr       string
+	}{
+		{
+			name:          "actions",
+			oidc:          OIDCOptions{ExchangeURL: srv.URL + "/exchange", Audience: "test-audience"},
+			env:           true,
+			wantToken:     "ghs_actions-test-audience",
+			wantExpiresAt: expiresAt,
+		},
+		{
+			name:    "actions_not_configured",
+			oidc:    OIDCOptions{ExchangeURL: srv.URL + "/exchange", Audience: "test-audience"},
+			wantErr: "ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN aren't set",
+		},
+		{
+			name:          "command",
+			oidc:          OIDCOptions{ExchangeURL: srv.URL + "/exchange", IDTokenCommand: []string{"echo", "command-token"}},
+			wantToken:     "ghs_command-token",
+			wantExpiresAt: expiresAt,
+		},
+		{
+			name:    "command_failed",
+			oidc:    OIDCOptions{ExchangeURL: srv.URL + "/exchange", IDTokenCommand: []string{"false"}},
+			wantErr: `command "false" failed`,
+		},
+		{
+			name:          "no_expiry",
+			oidc:          OIDCOptions{ExchangeURL: srv.URL + "/exchange-no-expiry", IDTokenCommand: []string{"echo", "command-token"}},
+			wantToken:     "ghs_token",
+			wantExpiresAt: time.Now().Add(exchangedTokenLifetime),
+		},
+		{
+			name:    "denied",
+			oidc:    OIDCOptions{ExchangeURL: srv.URL + "/exchange-denied", IDTokenCommand: []string{"echo", "command-token"}},
+			wantErr: `unexpected status 403`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.env {
+				t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", srv.URL+"/actions?api-version=2.0")
+				t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+			} else {
+				t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "")
+				t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "")
+			}
+
+			token, err := oidcTokenSource(tc.oidc)(t.Context())
+			if len(tc.wantErr) != 0 {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if token.Value != tc.wantToken {
+				t.Errorf("expected token %q, got %q", tc.wantToken, token.Value)
+			}
+			if d := token.ExpiresAt.Sub(tc.wantExpiresAt).Abs(); d > time.Minute {
+				t.Errorf("expected expiry %v, got %v", tc.wantExpiresAt, token.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestNewOIDCClientCreator(t *testing.T) {
+	for _, u := range []string{"", "/exchange", "not a url"} {
+		if _, err := NewOIDCClientCreator(OIDCOptions{ExchangeURL: u}, ClientOptions{}); err == nil {
+			t.Errorf("expected an error for exchange URL %q", u)
+		}
+	}
+
+	if _, err := NewOIDCClientCreator(OIDCOptions{ExchangeURL: "https://octo-sts.dev/sts/exchange?scope=test-org"}, ClientOptions{}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package ghutil
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// DefaultPerPage is the page size requested by the pagination helpers, which is the maximum supported by the GitHub API.
+const DefaultPerPage = 100
+
+// ListFunc returns a page of items for the list options.
+type ListFunc[T any] func(opts github.ListOptions) ([]T, *github.Response, error)
+
+// Paginate returns an iterator over the items from every page returned by list, starting with the first page. The next page
+// is taken from Response.NextPage, falling back to the "next" link in the Link header. Iteration stops after the first error,
+// which is yielded with the zero value of T.
+func Paginate[T any](list ListFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		opts := github.ListOptions{PerPage: DefaultPerPage}
+		for {
+			items, resp, err := list(opts)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			next := nextPage(resp)
+			if next == 0 || next == opts.Page {
+				return
+			}
+			opts.Page = next
+		}
+	}
+}
+
+// PaginateURL returns an iterator over the items returned by a GET request to the URL, which is relative to the client base URL,
+// following the "next" link in the Link header of each response. This supports endpoints which don't have list options in the
+// client. Iteration stops after the first error, which is yielded with the zero value of T.
+func PaginateURL[T any](ctx context.Context, client *github.Client, u string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		next, err := withPerPage(u)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+
+		for len(next) != 0 {
+			req, err := client.NewRequest(http.MethodGet, next, nil)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			var items []T
+			resp, err := client.Do(ctx, req, &items)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			next = nextLink(resp)
+		}
+	}
+}
+
+// Collect returns all the items from the iterator, or the first error.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// ListAll returns the items from every page returned by list.
+func ListAll[T any](list ListFunc[T]) ([]T, error) {
+	return Collect(Paginate(list))
+}
+
+// nextPage returns the next page number for the response, or 0 if this is the last page.
+func nextPage(resp *github.Response) int {
+	if resp == nil {
+		return 0
+	}
+
+	if resp.NextPage != 0 {
+		return resp.NextPage
+	}
+
+	next := nextLink(resp)
+	if len(next) == 0 {
+		return 0
+	}
+
+	u, err := url.Parse(next)
+	if err != nil {
+		return 0
+	}
+
+	page, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil {
+		return 0
+	}
+
+	return page
+}
+
+// nextLink returns the URL of the "next" link in the Link header of the response, or an empty string if there isn't one.
+func nextLink(resp *github.Response) string {
+	if resp == nil || resp.Response == nil {
+		return ""
+	}
+
+	for _, header := range resp.Header.Values("Link") {
+		for link := range strings.SplitSeq(header, ",") {
+			target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+			if !ok {
+				continue
+			}
+
+			for param := range strings.SplitSeq(params, ";") {
+				if strings.TrimSpace(param) == `rel="next"` {
+					return strings.Trim(strings.TrimSpace(target), "<>")
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// withPerPage returns the URL with the "per_page" query parameter set to the default if it isn't already set.
+func withPerPage(u string) (string, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", fmt.Errorf("invalid list URL %q: %w", u, err)
+	}
+
+	q := parsed.Query()
+	if len(q.Get("per_page")) == 0 {
+		q.Set("per_page", strconv.Itoa(DefaultPerPage))
+		parsed.RawQuery = q.Encode()
+	}
+
+	return parsed.String(), nil
+}
@@ -0,0 +1,200 @@
+package ghutil
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// testPagedServer returns a client for a server that returns the items in pages of the requested size from the path, using
+// page numbers if cursor is false and opaque cursors otherwise.
+func testPagedServer(t *testing.T, path string, items []string, cursor bool) (*github.Client, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if perPage == 0 {
+			perPage = 30
+		}
+
+		page := 1
+		if cursor {
+			if after := r.URL.Query().Get("after"); len(after) != 0 {
+				page, _ = strconv.Atoi(after[len("cursor-"):])
+			}
+		} else if p := r.URL.Query().Get("page"); len(p) != 0 {
+			page, _ = strconv.Atoi(p)
+		}
+
+		start := min((page-1)*perPage, len(items))
+		end := min(start+perPage, len(items))
+
+		if end < len(items) {
+			next := fmt.Sprintf("http://%s%s?per_page=%d&page=%d", r.Host, r.URL.Path, perPage, page+1)
+			if cursor {
+				next = fmt.Sprintf("http://%s%s?per_page=%d&after=cursor-%d", r.Host, r.URL.Path, perPage, page+1)
+			}
+			w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, "[")
+		for i, item := range items[start:end] {
+			if i > 0 {
+				_, _ = fmt.Fprint(w, ",")
+			}
+			_, _ = fmt.Fprintf(w, `{"login":%q,"property_name":%q}`, item, item)
+		}
+		_, _ = fmt.Fprint(w, "]")
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewGitHubClient(github.Ptr("token"), ClientOptions{BaseURL: srv.URL, Retry: &RetryOptions{MaxAttempts: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, &requests
+}
+
+// testItems returns n item names.
+func testItems(n int) []string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf("item-%03d", i)
+	}
+	return items
+}
+
+func TestPaginate(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		items        int
+		wantRequests int32
+	}{
+		{name: "empty", items: 0, wantRequests: 1},
+		{name: "single_page", items: 100, wantRequests: 1},
+		{name: "multiple_pages", items: 250, wantRequests: 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			items := testItems(tc.items)
+			client, requests := testPagedServer(t, "/api/v3/orgs/test-org/teams/test-team/members", items, false)
+
+			users, err := ListAll(func(opts github.ListOptions) ([]*github.User, *github.Response, error) {
+				return client.Teams.ListTeamMembersBySlug(t.Context(), "test-org", "test-team", &github.TeamListTeamMembersOptions{ListOptions: opts})
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var logins []string
+			for _, u := range users {
+				logins = append(logins, u.GetLogin())
+			}
+
+			if !slices.Equal(logins, items) {
+				t.Fatalf("expected %d items, got %d", len(items), len(logins))
+			}
+			if users == nil {
+				t.Fatal("expected a non-nil slice")
+			}
+			if got := requests.Load(); got != tc.wantRequests {
+				t.Fatalf("expected %d requests, got %d", tc.wantRequests, got)
+			}
+		})
+	}
+
+	t.Run("stop_early", func(t *testing.T) {
+		client, requests := testPagedServer(t, "/api/v3/orgs/test-org/teams/test-team/members", testItems(250), false)
+
+		count := 0
+		for _, err := range Paginate(func(opts github.ListOptions) ([]*github.User, *github.Response, error) {
+			return client.Teams.ListTeamMembersBySlug(t.Context(), "test-org", "test-team", &github.TeamListTeamMembersOptions{ListOptions: opts})
+		}) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			count++
+			if count == 150 {
+				break
+			}
+		}
+
+		if got := requests.Load(); got != 2 {
+			t.Fatalf("expected 2 requests, got %d", got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		client, _ := testPagedServer(t, "/api/v3/orgs/test-org/teams/test-team/members", testItems(10), false)
+
+		_, err := ListAll(func(opts github.ListOptions) ([]*github.User, *github.Response, error) {
+			return client.Teams.ListTeamMembersBySlug(t.Context(), "test-org", "missing-team", &github.TeamListTeamMembersOptions{ListOptions: opts})
+		})
+		if !IsNotFound(err) {
+			t.Fatalf("expected a not found error, got %v", err)
+		}
+	})
+}
+
+func TestPaginateURL(t *testing.T) {
+	items := testItems(250)
+	client, requests := testPagedServer(t, "/api/v3/orgs/test-org/properties/schema", items, true)
+
+	properties, err := Collect(PaginateURL[*github.CustomProperty](t.Context(), client, "orgs/test-org/properties/schema"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, p := range properties {
+		names = append(names, p.GetPropertyName())
+	}
+
+	if !slices.Equal(names, items) {
+		t.Fatalf("expected %d items, got %d", len(items), len(names))
+	}
+	if got := requests.Load(); got != 3 {
+		t.Fatalf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		nextPage int
+		link     string
+		want     int
+	}{
+		{name: "next_page", nextPage: 2, want: 2},
+		{name: "link", link: `<https://api.github.com/orgs/o/teams?page=1>; rel="prev", <https://api.github.com/orgs/o/teams?page=3>; rel="next"`, want: 3},
+		{name: "cursor_link", link: `<https://api.github.com/orgs/o/teams?after=abc>; rel="next"`, want: 0},
+		{name: "last_page", link: `<https://api.github.com/orgs/o/teams?page=1>; rel="first"`, want: 0},
+		{name: "no_link", want: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &github.Response{Response: &http.Response{Header: http.Header{}}, NextPage: tc.nextPage}
+			if len(tc.link) != 0 {
+				resp.Header.Set("Link", tc.link)
+			}
+
+			if got := nextPage(resp); got != tc.want {
+				t.Fatalf("expected next page %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
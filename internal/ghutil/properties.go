@@ -0,0 +1,21 @@
+package ghutil
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// ListCustomProperties returns all the custom properties defined for an organization. The client methods don't support
+// pagination for this endpoint, so the Link header is followed directly.
+func ListCustomProperties(ctx context.Context, client *github.Client, org string) ([]*github.CustomProperty, error) {
+	return Collect(PaginateURL[*github.CustomProperty](ctx, client, fmt.Sprintf("orgs/%s/properties/schema", url.PathEscape(org))))
+}
+
+// ListCustomPropertyValues returns all the custom property values set for a repository. The client methods don't support
+// pagination for this endpoint, so the Link header is followed directly.
+func ListCustomPropertyValues(ctx context.Context, client *github.Client, owner, repo string) ([]*github.CustomPropertyValue, error) {
+	return Collect(PaginateURL[*github.CustomPropertyValue](ctx, client, fmt.Sprintf("repos/%s/%s/properties/values", url.PathEscape(owner), url.PathEscape(repo))))
+}
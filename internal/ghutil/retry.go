@@ -0,0 +1,188 @@
+package ghutil
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryOptions configures how requests that fail with a transient error are retried.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of times a request is attempted, including the first attempt.
+	MaxAttempts int
+	// BaseBackoff is the backoff before the first retry, it doubles for each subsequent retry.
+	BaseBackoff time.Duration
+	// MaxBackoff is the maximum backoff between retries.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are the response status codes that are retried.
+	RetryableStatusCodes []int
+	// NonIdempotent allows POST and PATCH requests to be retried, which can repeat an action if the request was processed before it
+	// failed; otherwise only GET, HEAD, OPTIONS, PUT and DELETE requests are retried.
+	NonIdempotent bool
+}
+
+// DefaultRetryOptions returns the default retry options.
+func DefaultRetryOptions() *RetryOptions {
+	return &RetryOptions{
+		MaxAttempts:          3,
+		BaseBackoff:          time.Second,
+		MaxBackoff:           30 * time.Second,
+		RetryableStatusCodes: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	}
+}
+
+// retryTransport is a http.RoundTripper that retries requests which fail with a transient error.
+type retryTransport struct {
+	parent http.RoundTripper
+	opts   *RetryOptions
+	sleep  func(req *http.Request, d time.Duration) error
+}
+
+// newRetryTransport creates a new http.RoundTripper that retries requests using the options.
+func newRetryTransport(tr http.RoundTripper, opts *RetryOptions) http.RoundTripper {
+	return &retryTransport{
+		parent: tr,
+		opts:   opts,
+		sleep:  sleepContext,
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := t.parent.RoundTrip(r)
+
+		if attempt >= t.opts.MaxAttempts || !t.retryable(req) {
+			return resp, err
+		}
+
+		reason := t.retryReason(resp, err)
+		if len(reason) == 0 {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		backoff := t.backoff(attempt)
+		tflog.Warn(req.Context(), "Retrying GitHub API request.", map[string]any{
+			"method":       req.Method,
+			"url":          req.URL.String(),
+			"attempt":      attempt,
+			"max_attempts": t.opts.MaxAttempts,
+			"backoff":      backoff.String(),
+			"reason":       reason,
+		})
+
+		if err := t.sleep(req, backoff); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable returns true if the request can be sent again; a failed request may already have been processed, so only idempotent
+// requests are retried unless non-idempotent retries are enabled.
+func (t *retryTransport) retryable(req *http.Request) bool {
+	if !rewindable(req) {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return t.opts.NonIdempotent
+	}
+}
+
+// retryReason returns why the request should be retried, or an empty string if it shouldn't be.
+func (t *retryTransport) retryReason(resp *http.Response, err error) string {
+	// An error means that no response was received.
+	if err != nil {
+		if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return err.Error()
+		}
+		return ""
+	}
+
+	if slices.Contains(t.opts.RetryableStatusCodes, resp.StatusCode) {
+		return resp.Status
+	}
+
+	if isSecondaryRateLimit(resp) {
+		return "secondary rate limit"
+	}
+
+	return ""
+}
+
+// backoff returns the jittered exponential backoff before the next attempt.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	d := t.opts.BaseBackoff
+	for i := 1; i < attempt && d < t.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, t.opts.MaxBackoff)
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + rand.N(d/2+1)
+}
+
+// isSecondaryRateLimit returns true if the response is a secondary rate limit 403 without the headers that the rate limit transport
+// uses to wait; the body is restored so it can still be read.
+func isSecondaryRateLimit(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusForbidden || len(resp.Header.Get("Retry-After")) != 0 || resp.Header.Get("X-Ratelimit-Remaining") == "0" {
+		return false
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return false
+	}
+
+	msg := strings.ToLower(string(b))
+	return strings.Contains(msg, "secondary rate limit") || strings.Contains(msg, "abuse detection")
+}
+
+// rewindable returns true if the request body can be sent again.
+func rewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// sleepContext waits for the duration or until the request context is done.
+func sleepContext(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
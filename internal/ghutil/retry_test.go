@@ -0,0 +1,241 @@
+package ghutil
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		method        string
+		body          string
+		nonIdempotent bool
+		responses     []func(w http.ResponseWriter)
+		wantStatus    int
+		wantErr       bool
+		wantAttempts  int32
+	}{
+		{
+			name: "success",
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 1,
+		},
+		{
+			name: "server_error",
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusBadGateway, nil, ""),
+				respond(http.StatusServiceUnavailable, nil, ""),
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		{
+			name: "max_attempts",
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusInternalServerError, nil, ""),
+				respond(http.StatusInternalServerError, nil, ""),
+				respond(http.StatusInternalServerError, nil, ""),
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusInternalServerError,
+			wantAttempts: 3,
+		},
+		{
+			name: "not_retryable",
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusNotFound, nil, ""),
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusNotFound,
+			wantAttempts: 1,
+		},
+		{
+			name: "secondary_rate_limit",
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusForbidden, nil, `{"message":"You have exceeded a secondary rate limit."}`),
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name: "forbidden",
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusForbidden, nil, `{"message":"Resource not accessible by integration"}`),
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusForbidden,
+			wantAttempts: 1,
+		},
+		{
+			name: "secondary_rate_limit_headers",
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusForbidden, http.Header{"Retry-After": []string{"60"}}, `{"message":"You have exceeded a secondary rate limit."}`),
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusForbidden,
+			wantAttempts: 1,
+		},
+		{
+			name:   "connection_reset",
+			method: http.MethodPut,
+			body:   `{"name":"test"}`,
+			responses: []func(w http.ResponseWriter){
+				resetConnection,
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		{
+			name:   "post_server_error",
+			method: http.MethodPost,
+			body:   `{"name":"test"}`,
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusBadGateway, nil, ""),
+				respond(http.StatusCreated, nil, ""),
+			},
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 1,
+		},
+		{
+			name:   "post_connection_reset",
+			method: http.MethodPost,
+			body:   `{"name":"test"}`,
+			responses: []func(w http.ResponseWriter){
+				resetConnection,
+				respond(http.StatusCreated, nil, ""),
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name:   "patch_secondary_rate_limit",
+			method: http.MethodPatch,
+			body:   `{"name":"test"}`,
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusForbidden, nil, `{"message":"You have exceeded a secondary rate limit."}`),
+				respond(http.StatusOK, nil, ""),
+			},
+			wantStatus:   http.StatusForbidden,
+			wantAttempts: 1,
+		},
+		{
+			name:          "post_non_idempotent",
+			method:        http.MethodPost,
+			body:          `{"name":"test"}`,
+			nonIdempotent: true,
+			responses: []func(w http.ResponseWriter){
+				respond(http.StatusBadGateway, nil, ""),
+				respond(http.StatusCreated, nil, ""),
+			},
+			wantStatus:   http.StatusCreated,
+			wantAttempts: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+
+				b, err := io.ReadAll(r.Body)
+				if err != nil || string(b) != tc.body {
+					t.Errorf("attempt %d: unexpected body %q", n, string(b))
+				}
+
+				tc.responses[n-1](w)
+			}))
+			t.Cleanup(srv.Close)
+
+			tr := &retryTransport{
+				parent: http.DefaultTransport,
+				opts: &RetryOptions{
+					MaxAttempts:          3,
+					BaseBackoff:          time.Millisecond,
+					MaxBackoff:           time.Second,
+					RetryableStatusCodes: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable},
+					NonIdempotent:        tc.nonIdempotent,
+				},
+				sleep: func(req *http.Request, d time.Duration) error { return nil },
+			}
+
+			method := tc.method
+			if len(method) == 0 {
+				method = http.MethodGet
+			}
+			req, err := http.NewRequest(method, srv.URL, strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := tr.RoundTrip(req)
+			if got := attempts.Load(); got != tc.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tc.wantAttempts, got)
+			}
+
+			if tc.wantErr {
+				if err == nil {
+					_ = resp.Body.Close()
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d", tc.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	tr := &retryTransport{opts: &RetryOptions{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}}
+
+	for _, tc := range []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: time.Second},
+		{attempt: 2, max: 2 * time.Second},
+		{attempt: 3, max: 4 * time.Second},
+		{attempt: 4, max: 5 * time.Second},
+		{attempt: 100, max: 5 * time.Second},
+	} {
+		if d := tr.backoff(tc.attempt); d < tc.max/2 || d > tc.max {
+			t.Errorf("attempt %d: expected backoff between %s and %s, got %s", tc.attempt, tc.max/2, tc.max, d)
+		}
+	}
+}
+
+// respond returns a function that writes a response with the status, headers and body.
+func respond(status int, header http.Header, body string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}
+}
+
+// resetConnection closes the connection without writing a response.
+func resetConnection(w http.ResponseWriter) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err == nil {
+		_ = conn.Close()
+	}
+}
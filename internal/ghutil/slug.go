@@ -0,0 +1,43 @@
+package ghutil
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// TeamSlug returns the slug for a team name and true if it's the slug GitHub generates. Runs of characters other than ASCII
+// letters, digits and "_" are replaced with a single "-", and leading or trailing dashes are trimmed. GitHub's rules are only
+// known for names containing ASCII letters, digits, spaces, "-" and "_", so for other names, or names without any characters
+// that can be used in a slug, the slug is a best effort with accents removed and false is returned.
+func TeamSlug(name string) (string, bool) {
+	var b strings.Builder
+	dash := false
+	known := true
+	for _, r := range norm.NFKD.String(name) {
+		if !teamSlugKnownRune(r) {
+			known = false
+		}
+
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'):
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			dash = true
+		}
+	}
+
+	return b.String(), known && b.Len() > 0
+}
+
+// teamSlugKnownRune returns true if GitHub's slug rules are known for the character.
+func teamSlugKnownRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == ' ' || r == '-' || r == '_'
+}
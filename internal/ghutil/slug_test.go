@@ -0,0 +1,29 @@
+package ghutil
+
+import "testing"
+
+func TestTeamSlug(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		want      string
+		wantKnown bool
+	}{
+		{name: "team", want: "team", wantKnown: true},
+		{name: "My Team", want: "my-team", wantKnown: true},
+		{name: "my_team", want: "my_team", wantKnown: true},
+		{name: "  Team  ", want: "team", wantKnown: true},
+		{name: "Team -- Name", want: "team-name", wantKnown: true},
+		{name: "Team 42", want: "team-42", wantKnown: true},
+		{name: "Café Team", want: "cafe-team"},
+		{name: "R&D", want: "r-d"},
+		{name: "開発チーム", want: ""},
+		{name: "", want: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, known := TeamSlug(tc.name)
+			if got != tc.want || known != tc.wantKnown {
+				t.Fatalf("expected %q (known %t), got %q (known %t)", tc.want, tc.wantKnown, got, known)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package ghutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	ghv75 "github.com/google/go-github/v75/github"
+)
+
+// TokenScope limits the permissions and repositories of a GitHub app installation token; the zero value requests a token with
+// all of the installation permissions for all of the installation repositories.
+type TokenScope struct {
+	// Permissions maps permission names, such as "contents" or "members", to the access level, which is "read", "write" or
+	// "admin"; if empty the token has all of the installation permissions.
+	Permissions map[string]string
+	// Repositories are the names of the repositories the token can access; if empty the token can access all of the installation
+	// repositories.
+	Repositories []string
+}
+
+// IsZero returns true if the scope doesn't limit the token.
+func (s TokenScope) IsZero() bool {
+	return len(s.Permissions) == 0 && len(s.Repositories) == 0
+}
+
+// Key returns a canonical representation of the scope, so that scopes with the same permissions and repositories have the same key
+// regardless of order.
+func (s TokenScope) Key() string {
+	if s.IsZero() {
+		return ""
+	}
+
+	perms := make([]string, 0, len(s.Permissions))
+	for _, name := range slices.Sorted(maps.Keys(s.Permissions)) {
+		perms = append(perms, fmt.Sprintf("%s=%s", name, s.Permissions[name]))
+	}
+
+	repos := make([]string, 0, len(s.Repositories))
+	for _, r := range s.Repositories {
+		repos = append(repos, strings.ToLower(r))
+	}
+	slices.Sort(repos)
+	repos = slices.Compact(repos)
+
+	return fmt.Sprintf("%s;%s", strings.Join(perms, ","), strings.Join(repos, ","))
+}
+
+// Validate returns an error if a permission name or access level isn't supported by the GitHub API.
+func (s TokenScope) Validate() error {
+	_, err := s.installationTokenOptions()
+	return err
+}
+
+// installationTokenOptions returns the installation token options for the scope, or nil if the scope doesn't limit the token.
+func (s TokenScope) installationTokenOptions() (*ghv75.InstallationTokenOptions, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+
+	opts := &ghv75.InstallationTokenOptions{}
+
+	if len(s.Repositories) != 0 {
+		opts.Repositories = slices.Clone(s.Repositories)
+	}
+
+	if len(s.Permissions) != 0 {
+		for name, level := range s.Permissions {
+			switch level {
+			case "read", "write", "admin":
+			default:
+				return nil, fmt.Errorf("invalid access level %q for permission %q", level, name)
+			}
+		}
+
+		b, err := json.Marshal(s.Permissions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode permissions: %w", err)
+		}
+
+		// Decoding into the API type rejects permission names which the API doesn't support.
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.DisallowUnknownFields()
+
+		opts.Permissions = &ghv75.InstallationPermissions{}
+		if err := d.Decode(opts.Permissions); err != nil {
+			return nil, fmt.Errorf("invalid permissions: %w", err)
+		}
+	}
+
+	return opts, nil
+}
+
+// CreateInstallationToken creates an installation token limited to the scope, the client must be authenticated as the app.
+func CreateInstallationToken(ctx context.Context, client *github.Client, installationID int64, scope TokenScope) (*github.InstallationToken, error) {
+	opts, err := scope.installationTokenOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid token scope: %w", err)
+	}
+
+	// The options are converted through JSON as ghinstallation uses a different go-github version.
+	var tokenOpts *github.InstallationTokenOptions
+	if opts != nil {
+		b, err := json.Marshal(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode token options: %w", err)
+		}
+
+		tokenOpts = &github.InstallationTokenOptions{}
+		if err := json.Unmarshal(b, tokenOpts); err != nil {
+			return nil, fmt.Errorf("failed to decode token options: %w", err)
+		}
+	}
+
+	token, _, err := client.Apps.CreateInstallationToken(ctx, installationID, tokenOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
@@ -0,0 +1,90 @@
+package ghutil
+
+import (
+	"testing"
+)
+
+func TestTokenScopeKey(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		a    TokenScope
+		b    TokenScope
+		same bool
+	}{
+		{
+			name: "zero",
+			a:    TokenScope{},
+			b:    TokenScope{Permissions: map[string]string{}, Repositories: []string{}},
+			same: true,
+		},
+		{
+			name: "repository_order_and_case",
+			a:    TokenScope{Repositories: []string{"repo-b", "Repo-A"}},
+			b:    TokenScope{Repositories: []string{"repo-a", "repo-b", "repo-b"}},
+			same: true,
+		},
+		{
+			name: "permissions",
+			a:    TokenScope{Permissions: map[string]string{"contents": "read", "members": "write"}},
+			b:    TokenScope{Permissions: map[string]string{"members": "write", "contents": "read"}},
+			same: true,
+		},
+		{
+			name: "different_level",
+			a:    TokenScope{Permissions: map[string]string{"contents": "read"}},
+			b:    TokenScope{Permissions: map[string]string{"contents": "write"}},
+		},
+		{
+			name: "permissions_and_repositories",
+			a:    TokenScope{Permissions: map[string]string{"contents": "read"}},
+			b:    TokenScope{Permissions: map[string]string{"contents": "read"}, Repositories: []string{"repo-a"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.a.Key() == tc.b.Key(); got != tc.same {
+				t.Fatalf("expected keys %q and %q to be the same: %t", tc.a.Key(), tc.b.Key(), tc.same)
+			}
+		})
+	}
+}
+
+func TestTokenScopeValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		scope   TokenScope
+		wantErr string
+	}{
+		{
+			name:  "zero",
+			scope: TokenScope{},
+		},
+		{
+			name:  "valid",
+			scope: TokenScope{Permissions: map[string]string{"contents": "read", "members": "write", "administration": "admin"}, Repositories: []string{"repo-a"}},
+		},
+		{
+			name:    "invalid_level",
+			scope:   TokenScope{Permissions: map[string]string{"contents": "none"}},
+			wantErr: `invalid access level "none" for permission "contents"`,
+		},
+		{
+			name:    "unknown_permission",
+			scope:   TokenScope{Permissions: map[string]string{"everything": "read"}},
+			wantErr: `invalid permissions: json: unknown field "everything"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scope.Validate()
+			if len(tc.wantErr) != 0 {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("expected error %q, got: %v", tc.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package ghutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// TokenRefreshWindow is how long before a token expires that it's replaced, so that requests made during a long operation don't
+// fail part of the way through. Tokens with a shorter lifetime are replaced once half of their lifetime has passed.
+const TokenRefreshWindow = 5 * time.Minute
+
+// Token is a GitHub token with an optional expiry.
+type Token struct {
+	// Value is the token.
+	Value string
+	// ExpiresAt is when the token expires; if zero the token doesn't expire.
+	ExpiresAt time.Time
+}
+
+// TokenSource returns a new token.
+type TokenSource func(ctx context.Context) (Token, error)
+
+// tokenTransport is a http.RoundTripper which authenticates requests with a token from the source, getting a new token when
+// the current one is about to expire or has been rejected.
+type tokenTransport struct {
+	base      http.RoundTripper
+	source    TokenSource
+	mu        sync.Mutex
+	token     Token
+	refreshAt time.Time
+}
+
+// RoundTrip sets the token on a copy of the request and sends it.
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.current(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := t.base.RoundTrip(r)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		t.invalidate(token)
+	}
+
+	return resp, err
+}
+
+// current returns the current token, getting a new one from the source if there isn't one or it's due to be refreshed.
+func (t *tokenTransport) current(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.token.Value) != 0 && (t.refreshAt.IsZero() || time.Now().Before(t.refreshAt)) {
+		return t.token.Value, nil
+	}
+
+	token, err := t.source(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get token: %w", err)
+	}
+	if len(token.Value) == 0 {
+		return "", fmt.Errorf("failed to get token: token is empty")
+	}
+	t.token = token
+	t.refreshAt = time.Time{}
+
+	// The refresh window is limited to half of the token lifetime, so short lived tokens aren't replaced on every request.
+	if !token.ExpiresAt.IsZero() {
+		window := min(TokenRefreshWindow, max(time.Until(token.ExpiresAt)/2, 0))
+		t.refreshAt = token.ExpiresAt.Add(-window)
+	}
+
+	return token.Value, nil
+}
+
+// invalidate discards the token if it's still the current token, so the next request gets a new token from the source.
+func (t *tokenTransport) invalidate(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token.Value == token {
+		t.token = Token{}
+		t.refreshAt = time.Time{}
+	}
+}
+
+// tokenSourceClientCreator is responsible for creating GitHub clients which authenticate with tokens from a source.
+type tokenSourceClientCreator struct {
+	source   TokenSource
+	identity string
+	opts     ClientOptions
+	mu       sync.Mutex
+	client   *github.Client
+}
+
+// newTokenSourceClientCreator creates a ClientCreator that authenticates using tokens from the source, the identity of the source
+// is combined with the authenticated user, or the token if the user can't be read, for the request cache.
+func newTokenSourceClientCreator(source TokenSource, identity string, opts ClientOptions) *tokenSourceClientCreator {
+	return &tokenSourceClientCreator{
+		source:   source,
+		identity: identity,
+		opts:     opts,
+	}
+}
+
+// AppClient returns a GitHub app client.
+func (cc *tokenSourceClientCreator) AppClient() (*github.Client, error) {
+	return nil, fmt.Errorf("not an app client")
+}
+
+// DefaultClient returns the default GitHub client.
+func (cc *tokenSourceClientCreator) DefaultClient(ctx context.Context) (*github.Client, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if cc.client != nil {
+		return cc.client, nil
+	}
+
+	tr := &tokenTransport{base: http.DefaultTransport, source: cc.source}
+
+	identity, err := cc.cacheIdentity(ctx, tr)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newGitHubClient(tr, identity, cc.opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create github client: %w", err)
+	}
+	cc.client = c
+
+	return c, nil
+}
+
+// cacheIdentity returns the request cache identity for the tokens from the source. The same source can return tokens for
+// different users, such as a command after the active account is switched, so the identity includes the authenticated user; if
+// the user can't be read, such as for an installation token, the hash of the token is used instead.
+func (cc *tokenSourceClientCreator) cacheIdentity(ctx context.Context, tr *tokenTransport) (string, error) {
+	if cc.opts.Cache == nil {
+		return cc.identity, nil
+	}
+
+	token, err := tr.current(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	opts := cc.opts
+	opts.Cache = nil
+	c, err := newGitHubClient(tr, cc.identity, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to create github client: %w", err)
+	}
+
+	if u, _, err := c.Users.Get(ctx, ""); err == nil && u.GetID() != 0 {
+		return fmt.Sprintf("%s:user:%d", cc.identity, u.GetID()), nil
+	}
+
+	return fmt.Sprintf("%s:%s", cc.identity, tokenCacheIdentity(&token)), nil
+}
+
+// OrganizationClient returns a GitHub client for an organization.
+func (cc *tokenSourceClientCreator) OrganizationClient(ctx context.Context, organization string) (*github.Client, error) {
+	return cc.DefaultClient(ctx)
+}
+
+// ScopedOrganizationClient returns a GitHub client for an organization; the token source can't be limited so the scope is
+// ignored.
+func (cc *tokenSourceClientCreator) ScopedOrganizationClient(ctx context.Context, organization string, scope TokenScope) (*github.Client, error) {
+	return cc.DefaultClient(ctx)
+}
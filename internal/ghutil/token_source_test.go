@@ -0,0 +1,195 @@
+package ghutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestTokenSourceClientCreator(t *testing.T) {
+	var mu sync.Mutex
+	var auth []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		auth = append(auth, r.Header.Get("Authorization"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"login": "octocat"})
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, tc := range []struct {
+		name      string
+		expiresIn time.Duration
+		wantAuth  []string
+	}{
+		{
+			name:     "no_expiry",
+			wantAuth: []string{"Bearer token-1", "Bearer token-1", "Bearer token-1"},
+		},
+		{
+			name:      "not_expiring",
+			expiresIn: time.Hour,
+			wantAuth:  []string{"Bearer token-1", "Bearer token-1", "Bearer token-1"},
+		},
+		{
+			name:      "short_lived",
+			expiresIn: TokenRefreshWindow - time.Second,
+			wantAuth:  []string{"Bearer token-1", "Bearer token-1", "Bearer token-1"},
+		},
+		{
+			name:      "very_short_lived",
+			expiresIn: 10 * time.Second,
+			wantAuth:  []string{"Bearer token-1", "Bearer token-1", "Bearer token-1"},
+		},
+		{
+			name:      "expired",
+			expiresIn: -time.Second,
+			wantAuth:  []string{"Bearer token-1", "Bearer token-2", "Bearer token-3"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mu.Lock()
+			auth = nil
+			mu.Unlock()
+
+			calls := 0
+			cc := newTokenSourceClientCreator(func(ctx context.Context) (Token, error) {
+				calls++
+				token := Token{Value: fmt.Sprintf("token-%d", calls)}
+				if tc.expiresIn != 0 {
+					token.ExpiresAt = time.Now().Add(tc.expiresIn)
+				}
+				return token, nil
+			}, "test", ClientOptions{BaseURL: srv.URL})
+
+			for range tc.wantAuth {
+				client, err := cc.OrganizationClient(t.Context(), "test-org")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if _, _, err := client.Users.Get(t.Context(), ""); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if fmt.Sprint(auth) != fmt.Sprint(tc.wantAuth) {
+				t.Fatalf("expected authorization %v, got %v", tc.wantAuth, auth)
+			}
+		})
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		var auth []string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = append(auth, r.Header.Get("Authorization"))
+
+			w.Header().Set("Content-Type", "application/json")
+			if r.Header.Get("Authorization") == "Bearer token-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]any{"message": "Bad credentials"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"login": "octocat"})
+		}))
+		t.Cleanup(srv.Close)
+
+		calls := 0
+		cc := newTokenSourceClientCreator(func(ctx context.Context) (Token, error) {
+			calls++
+			return Token{Value: fmt.Sprintf("token-%d", calls), ExpiresAt: time.Now().Add(time.Hour)}, nil
+		}, "test", ClientOptions{BaseURL: srv.URL})
+
+		client, err := cc.DefaultClient(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err := client.Users.Get(t.Context(), ""); err == nil {
+			t.Fatal("expected an error")
+		}
+
+		for range 2 {
+			if _, _, err := client.Users.Get(t.Context(), ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		want := []string{"Bearer token-1", "Bearer token-2", "Bearer token-2"}
+		if fmt.Sprint(auth) != fmt.Sprint(want) {
+			t.Fatalf("expected authorization %v, got %v", want, auth)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cc := newTokenSourceClientCreator(func(ctx context.Context) (Token, error) {
+			return Token{}, fmt.Errorf("no token")
+		}, "test", ClientOptions{BaseURL: srv.URL})
+
+		client, err := cc.DefaultClient(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err := client.Users.Get(t.Context(), ""); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestTokenSourceCacheIdentity(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Header.Get("Authorization") {
+		case "Bearer gho_octocat":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 1, "login": "octocat"})
+		case "Bearer gho_monalisa":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 2, "login": "monalisa"})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]any{"message": "Resource not accessible by integration"})
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		cache bool
+		want  string
+	}{
+		{name: "user", token: "gho_octocat", cache: true, want: "command:user:1"},
+		{name: "switched_user", token: "gho_monalisa", cache: true, want: "command:user:2"},
+		{name: "installation", token: "ghs_installation", cache: true, want: "command:" + tokenCacheIdentity(github.Ptr("ghs_installation"))},
+		{name: "no_cache", token: "gho_octocat", want: "command"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := ClientOptions{BaseURL: srv.URL}
+			if tc.cache {
+				opts.Cache = &CacheOptions{Directory: t.TempDir()}
+			}
+
+			cc := newTokenSourceClientCreator(func(ctx context.Context) (Token, error) {
+				return Token{Value: tc.token}, nil
+			}, "command", opts)
+
+			got, err := cc.cacheIdentity(t.Context(), &tokenTransport{base: http.DefaultTransport, source: cc.source})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("expected identity %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -1,12 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghfake"
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
 )
 
 type accAuthType string
@@ -15,6 +23,7 @@ const (
 	accAuthTypeUnauthenticated     accAuthType = "UNAUTHENTICATED"
 	accAuthTypePersonalAccessToken accAuthType = "PERSONAL_ACCESS_TOKEN"
 	accAuthTypeGitHubApp           accAuthType = "GITHUB_APP"
+	accAuthTypeFake                accAuthType = "FAKE"
 )
 
 type accTestConfig struct {
@@ -28,83 +37,353 @@ type accTestFeatures struct {
 	Organization     bool
 	Enterprise       bool
 	AdvancedSecurity bool
+	ExternalGroups   bool
+	TeamSync         bool
 }
 
 type accTestValues struct {
-	Username     string
-	Organization string
-	TeamSlug     string
+	Username      string
+	Organization  string
+	TeamSlug      string
+	ExternalGroup string
+	IDPGroupID    string
+	IDPGroupName  string
 }
 
+// accTestResourcePrefix is the start of the prefix for the names of the objects created by the acceptance tests, which the
+// sweepers match when the "-sweep" flag doesn't provide a prefix.
+const accTestResourcePrefix = "tf-acc-"
+
 var accTestConfigData accTestConfig
 
 func TestMain(m *testing.M) {
 	accTestConfigData = accTestConfig{
-		ResourcePrefix: fmt.Sprintf("test-acc-%s-", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
+		ResourcePrefix: fmt.Sprintf("%s%s-", accTestResourcePrefix, acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)),
 		AuthType:       accAuthType(strings.ToUpper(os.Getenv("ACC_GITHUB_AUTH_TYPE"))),
 		Features: accTestFeatures{
 			Organization:     os.Getenv("ACC_GITHUB_FEATURE_ORGANIZATION") == "true",
 			Enterprise:       os.Getenv("ACC_GITHUB_FEATURE_ENTERPRISE") == "true",
 			AdvancedSecurity: os.Getenv("ACC_GITHUB_FEATURE_ADVANCED_SECURITY") == "true",
+			ExternalGroups:   os.Getenv("ACC_GITHUB_FEATURE_EXTERNAL_GROUPS") == "true",
+			TeamSync:         os.Getenv("ACC_GITHUB_FEATURE_TEAM_SYNC") == "true",
 		},
 		Values: accTestValues{
-			Username:     os.Getenv("ACC_GITHUB_VALUE_USERNAME"),
-			Organization: os.Getenv("ACC_GITHUB_VALUE_ORGANIZATION"),
-			TeamSlug:     os.Getenv("ACC_GITHUB_VALUE_TEAM"),
+			Username:      os.Getenv("ACC_GITHUB_VALUE_USERNAME"),
+			Organization:  os.Getenv("ACC_GITHUB_VALUE_ORGANIZATION"),
+			TeamSlug:      os.Getenv("ACC_GITHUB_VALUE_TEAM"),
+			ExternalGroup: os.Getenv("ACC_GITHUB_VALUE_EXTERNAL_GROUP"),
+			IDPGroupID:    os.Getenv("ACC_GITHUB_VALUE_IDP_GROUP_ID"),
+			IDPGroupName:  os.Getenv("ACC_GITHUB_VALUE_IDP_GROUP_NAME"),
 		},
 	}
 
-	// if accTestConfigValues.Authenticated {
-	// 	resource.TestMain(m)
-
-	// 	client, err := getGitHubClient(nil)
-	// 	if err != nil {
-	// 		fmt.Printf("error creating GitHub client: %v", err)
-	// 		os.Exit(1)
-	// 	}
-
-	// 	resource.AddTestSweepers("repos", &resource.Sweeper{
-	// 		Name: "repos",
-	// 		F: func(prefix string) error {
-	// 			repos, _, err := client.Repositories.ListByOrg(context.Background(), accTestConfigValues.Owner, &github.RepositoryListByOrgOptions{})
-	// 			if err != nil {
-	// 				return err
-	// 			}
-
-	// 			for _, r := range repos {
-	// 				if name := r.GetName(); strings.HasPrefix(name, prefix) {
-	// 					if _, err := client.Repositories.Delete(context.Background(), accTestConfigValues.Owner, name); err != nil {
-	// 						return err
-	// 					}
-	// 				}
-	// 			}
-
-	// 			return nil
-	// 		},
-	// 	})
-
-	// 	if accTestConfigValues.OwnerType != "USER" {
-	// 		resource.AddTestSweepers("teams", &resource.Sweeper{
-	// 			Name: "teams",
-	// 			F: func(prefix string) error {
-	// 				teams, _, err := client.Teams.ListTeams(context.Background(), accTestConfigValues.Owner, &github.ListOptions{})
-	// 				if err != nil {
-	// 					return err
-	// 				}
-
-	// 				for _, t := range teams {
-	// 					if slug := t.GetSlug(); strings.HasPrefix(slug, prefix) {
-	// 						if _, err := client.Teams.DeleteTeamBySlug(context.Background(), accTestConfigValues.Owner, slug); err != nil {
-	// 							return err
-	// 						}
-	// 					}
-	// 				}
-
-	// 				return nil
-	// 			},
-	// 		})
-	// 	}
-	// }
-
-	m.Run()
+	if accTestConfigData.AuthType == accAuthTypeFake {
+		// The fake API is stopped when the test process exits.
+		if _, err := accTestFakeGitHub(); err != nil {
+			fmt.Printf("error creating fake GitHub API: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	resource.AddTestSweepers("github_team_membership", &resource.Sweeper{
+		Name: "github_team_membership",
+		F:    accTestSweepTeamMemberships,
+	})
+
+	resource.AddTestSweepers("github_team", &resource.Sweeper{
+		Name:         "github_team",
+		Dependencies: []string{"github_team_membership"},
+		F:            accTestSweepTeams,
+	})
+
+	resource.AddTestSweepers("github_repository", &resource.Sweeper{
+		Name: "github_repository",
+		F:    accTestSweepRepositories,
+	})
+
+	resource.AddTestSweepers("github_organization_property", &resource.Sweeper{
+		Name:         "github_organization_property",
+		Dependencies: []string{"github_repository"},
+		F:            accTestSweepOrganizationProperties,
+	})
+
+	resource.TestMain(m)
+}
+
+// accTestFakeGitHub starts a fake GitHub API for the acceptance tests to run against, the provider is configured to use it
+// through the environment and the test values are set to match the seeded data.
+func accTestFakeGitHub() (*ghfake.Server, error) {
+	srv := ghfake.NewServer("octocat")
+
+	srv.AddOrganization(github.Organization{ID: github.Ptr(int64(9919)), Login: github.Ptr("github"), Name: github.Ptr("GitHub")})
+	srv.AddOrganization(github.Organization{Login: github.Ptr("fake-org"), Name: github.Ptr("Fake Org")})
+	srv.AddUser(github.User{Login: github.Ptr("hubot"), Name: github.Ptr("Hubot")})
+
+	if err := srv.AddOrganizationMember("fake-org", "hubot", "member"); err != nil {
+		srv.Close()
+		return nil, err
+	}
+
+	if _, err := srv.AddTeam("fake-org", github.NewTeam{Name: "test-team"}); err != nil {
+		srv.Close()
+		return nil, err
+	}
+
+	if _, err := srv.AddExternalGroup("fake-org", "Engineering"); err != nil {
+		srv.Close()
+		return nil, err
+	}
+
+	if err := srv.AddIDPGroup("fake-org", "a1b2c3d4", "Engineering", "Engineering team"); err != nil {
+		srv.Close()
+		return nil, err
+	}
+
+	for k, v := range map[string]string{"GITHUB_BASE_URL": srv.URL(), "GITHUB_TOKEN": "fake"} {
+		if err := os.Setenv(k, v); err != nil {
+			srv.Close()
+			return nil, err
+		}
+	}
+
+	accTestConfigData.Features.Organization = true
+	accTestConfigData.Features.ExternalGroups = true
+	accTestConfigData.Features.TeamSync = true
+	accTestConfigData.Values = accTestValues{
+		Username:      "hubot",
+		Organization:  "fake-org",
+		TeamSlug:      "test-team",
+		ExternalGroup: "Engineering",
+		IDPGroupID:    "a1b2c3d4",
+		IDPGroupName:  "Engineering",
+	}
+
+	return srv, nil
+}
+
+// accTestSweeperClient returns a client for the test organization, authenticated in the same way as the provider.
+func accTestSweeperClient(ctx context.Context) (*github.Client, error) {
+	opts := ghutil.ClientOptions{BaseURL: os.Getenv("GITHUB_BASE_URL")}
+
+	var cc ghutil.ClientCreator
+	switch accTestConfigData.AuthType {
+	case accAuthTypeGitHubApp:
+		appID, err := strconv.ParseInt(os.Getenv("GITHUB_APP_ID"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GITHUB_APP_ID: %w", err)
+		}
+
+		var installationID int64
+		if v := os.Getenv("GITHUB_APP_INSTALLATION_ID"); len(v) != 0 {
+			if installationID, err = strconv.ParseInt(v, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid GITHUB_APP_INSTALLATION_ID: %w", err)
+			}
+		}
+
+		privateKey := []byte(os.Getenv("GITHUB_APP_PRIVATE_KEY"))
+		if v := os.Getenv("GITHUB_APP_PRIVATE_KEY_FILE"); len(privateKey) == 0 && len(v) != 0 {
+			if privateKey, err = os.ReadFile(v); err != nil {
+				return nil, fmt.Errorf("failed to read private key file: %w", err)
+			}
+		}
+
+		if cc, err = ghutil.NewAppClientCreator(appID, ghutil.AppKey{PEM: privateKey, Passphrase: os.Getenv("GITHUB_APP_PRIVATE_KEY_PASSPHRASE")}, installationID, accTestConfigData.Values.Organization, ghutil.TokenScope{}, 1, opts); err != nil {
+			return nil, err
+		}
+	default:
+		var err error
+		if cc, err = ghutil.NewClientCreator(github.Ptr(os.Getenv("GITHUB_TOKEN")), opts); err != nil {
+			return nil, err
+		}
+	}
+
+	return cc.OrganizationClient(ctx, accTestConfigData.Values.Organization)
+}
+
+// accTestSweepEnabled returns true if the sweepers can run against the test organization.
+func accTestSweepEnabled() bool {
+	return accTestConfigData.AuthType != accAuthTypeUnauthenticated && accTestConfigData.Features.Organization && len(accTestConfigData.Values.Organization) != 0
+}
+
+// accTestSweepPrefix returns the prefix of the objects to sweep, which is passed to the sweepers by the "-sweep" flag.
+func accTestSweepPrefix(prefix string) string {
+	if len(prefix) == 0 {
+		return accTestResourcePrefix
+	}
+
+	return prefix
+}
+
+// accTestSweepListTeams returns the teams in the test organization whose slug starts with the prefix.
+func accTestSweepListTeams(ctx context.Context, client *github.Client, prefix string) ([]*github.Team, error) {
+	all, err := ghutil.ListAll(func(opts github.ListOptions) ([]*github.Team, *github.Response, error) {
+		return client.Teams.ListTeams(ctx, accTestConfigData.Values.Organization, &opts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	var teams []*github.Team
+	for _, t := range all {
+		if strings.HasPrefix(t.GetSlug(), prefix) {
+			teams = append(teams, t)
+		}
+	}
+
+	return teams, nil
+}
+
+// accTestSweepTeamMemberships removes the members and pending members of the teams created by the acceptance tests.
+func accTestSweepTeamMemberships(prefix string) error {
+	if !accTestSweepEnabled() {
+		return nil
+	}
+
+	ctx := context.Background()
+	org := accTestConfigData.Values.Organization
+
+	client, err := accTestSweeperClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	teams, err := accTestSweepListTeams(ctx, client, accTestSweepPrefix(prefix))
+	if err != nil {
+		return err
+	}
+
+	for _, t := range teams {
+		var usernames []string
+
+		users, err := ghutil.ListAll(func(opts github.ListOptions) ([]*github.User, *github.Response, error) {
+			return client.Teams.ListTeamMembersBySlug(ctx, org, t.GetSlug(), &github.TeamListTeamMembersOptions{ListOptions: opts})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list members of team %s: %w", t.GetSlug(), err)
+		}
+
+		for _, u := range users {
+			usernames = append(usernames, u.GetLogin())
+		}
+
+		invitations, err := ghutil.ListAll(func(opts github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+			return client.Teams.ListPendingTeamInvitationsBySlug(ctx, org, t.GetSlug(), &opts)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list pending members of team %s: %w", t.GetSlug(), err)
+		}
+
+		for _, inv := range invitations {
+			if len(inv.GetLogin()) != 0 {
+				usernames = append(usernames, inv.GetLogin())
+			}
+		}
+
+		for _, username := range usernames {
+			if _, err := client.Teams.RemoveTeamMembershipBySlug(ctx, org, t.GetSlug(), username); err != nil && !ghutil.IsNotFound(err) {
+				return fmt.Errorf("failed to remove %s from team %s: %w", username, t.GetSlug(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// accTestSweepTeams deletes the teams created by the acceptance tests.
+func accTestSweepTeams(prefix string) error {
+	if !accTestSweepEnabled() {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	client, err := accTestSweeperClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	teams, err := accTestSweepListTeams(ctx, client, accTestSweepPrefix(prefix))
+	if err != nil {
+		return err
+	}
+
+	for _, t := range teams {
+		// Deleting a parent team also deletes its child teams, so they may already be gone.
+		if _, err := client.Teams.DeleteTeamBySlug(ctx, accTestConfigData.Values.Organization, t.GetSlug()); err != nil && !ghutil.IsNotFound(err) {
+			return fmt.Errorf("failed to delete team %s: %w", t.GetSlug(), err)
+		}
+	}
+
+	return nil
+}
+
+// accTestSweepRepositories deletes the repositories created by the acceptance tests.
+func accTestSweepRepositories(prefix string) error {
+	if !accTestSweepEnabled() {
+		return nil
+	}
+
+	ctx := context.Background()
+	org := accTestConfigData.Values.Organization
+	prefix = accTestSweepPrefix(prefix)
+
+	client, err := accTestSweeperClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	repos, err := ghutil.ListAll(func(opts github.ListOptions) ([]*github.Repository, *github.Response, error) {
+		return client.Repositories.ListByOrg(ctx, org, &github.RepositoryListByOrgOptions{ListOptions: opts})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	var names []string
+	for _, r := range repos {
+		if strings.HasPrefix(r.GetName(), prefix) {
+			names = append(names, r.GetName())
+		}
+	}
+
+	for _, name := range names {
+		if _, err := client.Repositories.Delete(ctx, org, name); err != nil && !ghutil.IsNotFound(err) {
+			return fmt.Errorf("failed to delete repository %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// accTestSweepOrganizationProperties deletes the organization custom properties created by the acceptance tests.
+func accTestSweepOrganizationProperties(prefix string) error {
+	if !accTestSweepEnabled() {
+		return nil
+	}
+
+	ctx := context.Background()
+	org := accTestConfigData.Values.Organization
+	prefix = accTestSweepPrefix(prefix)
+
+	client, err := accTestSweeperClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	properties, err := ghutil.ListCustomProperties(ctx, client, org)
+	if err != nil {
+		return fmt.Errorf("failed to list organization properties: %w", err)
+	}
+
+	for _, p := range properties {
+		if !strings.HasPrefix(p.GetPropertyName(), prefix) {
+			continue
+		}
+
+		if _, err := client.Organizations.RemoveCustomProperty(ctx, org, p.GetPropertyName()); err != nil && !ghutil.IsNotFound(err) {
+			return fmt.Errorf("failed to delete organization property %s: %w", p.GetPropertyName(), err)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,553 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                = &BranchProtectionResource{}
+	_ resource.ResourceWithConfigure   = &BranchProtectionResource{}
+	_ resource.ResourceWithImportState = &BranchProtectionResource{}
+)
+
+// NewBranchProtectionResource creates a new BranchProtectionResource.
+func NewBranchProtectionResource() resource.Resource {
+	return &BranchProtectionResource{}
+}
+
+// BranchProtectionResource defines the resource implementation.
+type BranchProtectionResource struct {
+	providerData *GitHubProviderData
+}
+
+// BranchProtectionModel describes the data model.
+type BranchProtectionModel struct {
+	AllowDeletions                 types.Bool                               `tfsdk:"allow_deletions"`
+	AllowForcePushes               types.Bool                               `tfsdk:"allow_force_pushes"`
+	Branch                         types.String                             `tfsdk:"branch"`
+	EnforceAdmins                  types.Bool                               `tfsdk:"enforce_admins"`
+	LockBranch                     types.Bool                               `tfsdk:"lock_branch"`
+	Organization                   types.String                             `tfsdk:"organization"`
+	Repository                     types.String                             `tfsdk:"repository"`
+	RequiredConversationResolution types.Bool                               `tfsdk:"required_conversation_resolution"`
+	RequiredLinearHistory          types.Bool                               `tfsdk:"required_linear_history"`
+	RequiredPullRequestReviews     *BranchProtectionPullRequestReviewsModel `tfsdk:"required_pull_request_reviews"`
+	RequiredSignatures             types.Bool                               `tfsdk:"required_signatures"`
+	RequiredStatusChecks           *BranchProtectionStatusChecksModel       `tfsdk:"required_status_checks"`
+	Restrictions                   *BranchProtectionRestrictionsModel       `tfsdk:"restrictions"`
+}
+
+// BranchProtectionPullRequestReviewsModel describes the required pull request reviews data model.
+type BranchProtectionPullRequestReviewsModel struct {
+	DismissStaleReviews          types.Bool  `tfsdk:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews      types.Bool  `tfsdk:"require_code_owner_reviews"`
+	RequireLastPushApproval      types.Bool  `tfsdk:"require_last_push_approval"`
+	RequiredApprovingReviewCount types.Int64 `tfsdk:"required_approving_review_count"`
+}
+
+// BranchProtectionStatusChecksModel describes the required status checks data model.
+type BranchProtectionStatusChecksModel struct {
+	Checks []BranchProtectionStatusCheckModel `tfsdk:"checks"`
+	Strict types.Bool                         `tfsdk:"strict"`
+}
+
+// BranchProtectionStatusCheckModel describes the required status check data model.
+type BranchProtectionStatusCheckModel struct {
+	AppID   types.Int64  `tfsdk:"app_id"`
+	Context types.String `tfsdk:"context"`
+}
+
+// BranchProtectionRestrictionsModel describes the push restrictions data model.
+type BranchProtectionRestrictionsModel struct {
+	Apps  []string `tfsdk:"apps"`
+	Teams []string `tfsdk:"teams"`
+	Users []string `tfsdk:"users"`
+}
+
+// Metadata returns the resource metadata.
+func (r *BranchProtectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_branch_protection", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *BranchProtectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptyStringSet := setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{}))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ branch protection resource (`github_branch_protection`) allows you to manage the classic protection of a branch in a _GitHub_ repository. Merge queues can only be required with a `github_repository_ruleset`.",
+		Attributes: map[string]schema.Attribute{
+			"allow_deletions": schema.BoolAttribute{
+				MarkdownDescription: "If users with push access can delete the branch.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_force_pushes": schema.BoolAttribute{
+				MarkdownDescription: "If users with push access can force push to the branch.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Name of the branch, which must exist; wildcards aren't supported.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enforce_admins": schema.BoolAttribute{
+				MarkdownDescription: "If the protection is also enforced for repository administrators.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"lock_branch": schema.BoolAttribute{
+				MarkdownDescription: "If the branch is read-only so that users can't push to it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization that owns the repository.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"required_conversation_resolution": schema.BoolAttribute{
+				MarkdownDescription: "If all conversations on code must be resolved before a pull request can be merged.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"required_linear_history": schema.BoolAttribute{
+				MarkdownDescription: "If merge commits are prevented from being pushed to the branch.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"required_pull_request_reviews": schema.SingleNestedAttribute{
+				MarkdownDescription: "Require pull request reviews before merging.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"dismiss_stale_reviews": schema.BoolAttribute{
+						MarkdownDescription: "If approving reviews are dismissed when new commits are pushed.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"require_code_owner_reviews": schema.BoolAttribute{
+						MarkdownDescription: "If an approving review is required from code owners for pull requests that modify owned files.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"require_last_push_approval": schema.BoolAttribute{
+						MarkdownDescription: "If the most recent push must be approved by someone other than the person who pushed it.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"required_approving_review_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of approving reviews required before a pull request can be merged; defaults to `1`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 6),
+						},
+					},
+				},
+			},
+			"required_signatures": schema.BoolAttribute{
+				MarkdownDescription: "If commits pushed to the branch must have verified signatures.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"required_status_checks": schema.SingleNestedAttribute{
+				MarkdownDescription: "Require status checks to pass before merging.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"checks": schema.SetNestedAttribute{
+						MarkdownDescription: "Status checks that are required.",
+						Optional:            true,
+						Computed:            true,
+						Default:             setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: branchProtectionStatusCheckAttrTypes()}, []attr.Value{})),
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"app_id": schema.Int64Attribute{
+									MarkdownDescription: "ID of the _GitHub_ app that must provide the status check; if this isn't set the app that most recently provided the check is used.",
+									Optional:            true,
+								},
+								"context": schema.StringAttribute{
+									MarkdownDescription: "Name of the status check context.",
+									Required:            true,
+								},
+							},
+						},
+					},
+					"strict": schema.BoolAttribute{
+						MarkdownDescription: "If branches must be up to date with the base branch before merging.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"restrictions": schema.SingleNestedAttribute{
+				MarkdownDescription: "Restrict who can push to the branch; if this is set only the listed users, teams and apps can push.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"apps": schema.SetAttribute{
+						MarkdownDescription: "Slugs of the _GitHub_ apps that can push.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Default:             emptyStringSet,
+					},
+					"teams": schema.SetAttribute{
+						MarkdownDescription: "Slugs of the teams that can push, such as `github_team.example.slug`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Default:             emptyStringSet,
+					},
+					"users": schema.SetAttribute{
+						MarkdownDescription: "Logins of the users that can push.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Default:             emptyStringSet,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *BranchProtectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
+}
+
+// Create creates the resource.
+func (r *BranchProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BranchProtectionModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.update(ctx, plan, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create branch protection.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read reads the resource state.
+func (r *BranchProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BranchProtectionModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	p, _, err := client.Repositories.GetBranchProtection(ctx, organization, state.Repository.ValueString(), state.Branch.ValueString())
+	if errors.Is(err, github.ErrBranchNotProtected) || ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get branch protection.", err.Error())
+		return
+	}
+
+	m := toBranchProtectionModel(state, p)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Update updates the resource.
+func (r *BranchProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state BranchProtectionModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	m, err := r.update(ctx, plan, state.RequiredSignatures.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update branch protection.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Delete deletes the resource.
+func (r *BranchProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BranchProtectionModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	_, err = client.Repositories.RemoveBranchProtection(ctx, organization, state.Repository.ValueString(), state.Branch.ValueString())
+	if err != nil && !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete branch protection.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *BranchProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization/repository", "branch")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	organization, repository, err := ghutil.ParseRepository(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), parts[1])...)
+}
+
+// update replaces the branch protection with the plan and returns the resulting model. Required signatures are managed through a
+// separate endpoint so are only changed if they differ from the current value.
+func (r *BranchProtectionResource) update(ctx context.Context, plan BranchProtectionModel, signatures bool) (BranchProtectionModel, error) {
+	organization := plan.Organization.ValueString()
+	repository := plan.Repository.ValueString()
+	branch := plan.Branch.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		return BranchProtectionModel{}, fmt.Errorf("failed to create organization client: %w", err)
+	}
+
+	p, _, err := client.Repositories.UpdateBranchProtection(ctx, organization, repository, branch, fromBranchProtectionModel(plan))
+	if err != nil {
+		return BranchProtectionModel{}, err
+	}
+
+	if plan.RequiredSignatures.ValueBool() != signatures {
+		if plan.RequiredSignatures.ValueBool() {
+			_, _, err = client.Repositories.RequireSignaturesOnProtectedBranch(ctx, organization, repository, branch)
+		} else {
+			_, err = client.Repositories.OptionalSignaturesOnProtectedBranch(ctx, organization, repository, branch)
+		}
+		if err != nil {
+			return BranchProtectionModel{}, fmt.Errorf("failed to update required signatures: %w", err)
+		}
+	}
+	p.RequiredSignatures = &github.SignaturesProtectedBranch{Enabled: plan.RequiredSignatures.ValueBoolPointer()}
+
+	return toBranchProtectionModel(plan, p), nil
+}
+
+// branchProtectionStatusCheckAttrTypes returns the attribute types of a required status check.
+func branchProtectionStatusCheckAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"app_id":  types.Int64Type,
+		"context": types.StringType,
+	}
+}
+
+// toBranchProtectionModel converts a branch protection to the resource model. GitHub sorts the restrictions and selects an app
+// for checks without one, so the logins and slugs keep the case of the known model and checks which don't have an app in the
+// known model don't get one.
+func toBranchProtectionModel(known BranchProtectionModel, p *github.Protection) BranchProtectionModel {
+	m := BranchProtectionModel{
+		AllowDeletions:                 types.BoolValue(p.AllowDeletions != nil && p.AllowDeletions.Enabled),
+		AllowForcePushes:               types.BoolValue(p.AllowForcePushes != nil && p.AllowForcePushes.Enabled),
+		Branch:                         known.Branch,
+		EnforceAdmins:                  types.BoolValue(p.EnforceAdmins != nil && p.EnforceAdmins.Enabled),
+		LockBranch:                     types.BoolValue(p.GetLockBranch().GetEnabled()),
+		Organization:                   known.Organization,
+		Repository:                     known.Repository,
+		RequiredConversationResolution: types.BoolValue(p.RequiredConversationResolution != nil && p.RequiredConversationResolution.Enabled),
+		RequiredLinearHistory:          types.BoolValue(p.RequireLinearHistory != nil && p.RequireLinearHistory.Enabled),
+		RequiredSignatures:             types.BoolValue(p.GetRequiredSignatures().GetEnabled()),
+	}
+
+	if rev := p.RequiredPullRequestReviews; rev != nil {
+		m.RequiredPullRequestReviews = &BranchProtectionPullRequestReviewsModel{
+			DismissStaleReviews:          types.BoolValue(rev.DismissStaleReviews),
+			RequireCodeOwnerReviews:      types.BoolValue(rev.RequireCodeOwnerReviews),
+			RequireLastPushApproval:      types.BoolValue(rev.RequireLastPushApproval),
+			RequiredApprovingReviewCount: types.Int64Value(int64(rev.RequiredApprovingReviewCount)),
+		}
+	}
+
+	if c := p.RequiredStatusChecks; c != nil {
+		withoutApp := map[string]bool{}
+		if known.RequiredStatusChecks != nil {
+			for _, check := range known.RequiredStatusChecks.Checks {
+				if check.AppID.IsNull() {
+					withoutApp[check.Context.ValueString()] = true
+				}
+			}
+		}
+
+		checks := make([]BranchProtectionStatusCheckModel, 0, len(c.GetChecks()))
+		for _, check := range c.GetChecks() {
+			appID := types.Int64PointerValue(check.AppID)
+			if withoutApp[check.Context] {
+				appID = types.Int64Null()
+			}
+
+			checks = append(checks, BranchProtectionStatusCheckModel{
+				AppID:   appID,
+				Context: types.StringValue(check.Context),
+			})
+		}
+
+		m.RequiredStatusChecks = &BranchProtectionStatusChecksModel{
+			Checks: checks,
+			Strict: types.BoolValue(c.Strict),
+		}
+	}
+
+	if res := p.Restrictions; res != nil {
+		var knownRes BranchProtectionRestrictionsModel
+		if known.Restrictions != nil {
+			knownRes = *known.Restrictions
+		}
+
+		apps := make([]string, 0, len(res.Apps))
+		for _, a := range res.Apps {
+			apps = append(apps, keepCase(a.GetSlug(), knownRes.Apps))
+		}
+
+		teams := make([]string, 0, len(res.Teams))
+		for _, t := range res.Teams {
+			teams = append(teams, keepCase(t.GetSlug(), knownRes.Teams))
+		}
+
+		users := make([]string, 0, len(res.Users))
+		for _, u := range res.Users {
+			users = append(users, keepCase(u.GetLogin(), knownRes.Users))
+		}
+
+		m.Restrictions = &BranchProtectionRestrictionsModel{Apps: apps, Teams: teams, Users: users}
+	}
+
+	return m
+}
+
+func fromBranchProtectionModel(m BranchProtectionModel) *github.ProtectionRequest {
+	req := &github.ProtectionRequest{
+		AllowDeletions:                 m.AllowDeletions.ValueBoolPointer(),
+		AllowForcePushes:               m.AllowForcePushes.ValueBoolPointer(),
+		EnforceAdmins:                  m.EnforceAdmins.ValueBool(),
+		LockBranch:                     m.LockBranch.ValueBoolPointer(),
+		RequireLinearHistory:           m.RequiredLinearHistory.ValueBoolPointer(),
+		RequiredConversationResolution: m.RequiredConversationResolution.ValueBoolPointer(),
+	}
+
+	if rev := m.RequiredPullRequestReviews; rev != nil {
+		req.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			DismissStaleReviews:          rev.DismissStaleReviews.ValueBool(),
+			RequireCodeOwnerReviews:      rev.RequireCodeOwnerReviews.ValueBool(),
+			RequireLastPushApproval:      rev.RequireLastPushApproval.ValueBoolPointer(),
+			RequiredApprovingReviewCount: int(rev.RequiredApprovingReviewCount.ValueInt64()),
+		}
+	}
+
+	if c := m.RequiredStatusChecks; c != nil {
+		checks := make([]*github.RequiredStatusCheck, 0, len(c.Checks))
+		for _, check := range c.Checks {
+			checks = append(checks, &github.RequiredStatusCheck{
+				AppID:   check.AppID.ValueInt64Pointer(),
+				Context: check.Context.ValueString(),
+			})
+		}
+
+		req.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Checks: &checks,
+			Strict: c.Strict.ValueBool(),
+		}
+	}
+
+	if res := m.Restrictions; res != nil {
+		req.Restrictions = &github.BranchRestrictionsRequest{
+			Apps:  emptyIfNil(res.Apps),
+			Teams: emptyIfNil(res.Teams),
+			Users: emptyIfNil(res.Users),
+		}
+	}
+
+	return req
+}
+
+// keepCase returns the known value which is equal to v ignoring case, as GitHub logins and slugs are case insensitive, otherwise
+// v is returned.
+func keepCase(v string, known []string) string {
+	for _, k := range known {
+		if strings.EqualFold(k, v) {
+			return k
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBranchProtectionResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("create_default", func(t *testing.T) {
+		repoName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  organization = "%s"
+  name         = "%s"
+  auto_init    = true
+}
+
+resource "github_branch_protection" "test" {
+  organization = "%[1]s"
+  repository   = github_repository.test.name
+  branch       = github_repository.test.default_branch
+}
+`, accTestConfigData.Values.Organization, repoName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("allow_deletions"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("enforce_admins"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_pull_request_reviews"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_signatures"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_status_checks"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("restrictions"), knownvalue.Null()),
+					},
+				},
+				{
+					ResourceName:                         "github_branch_protection.test",
+					ImportState:                          true,
+					ImportStateId:                        fmt.Sprintf("%s/%s:main", accTestConfigData.Values.Organization, repoName),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "branch",
+				},
+			},
+		})
+	})
+
+	t.Run("create_and_update", func(t *testing.T) {
+		repoName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  organization = "%s"
+  name         = "%s"
+  auto_init    = true
+}
+
+resource "github_team" "test" {
+  organization = "%[1]s"
+  name         = "%[3]s"
+}
+
+resource "github_branch_protection" "test" {
+  organization            = "%[1]s"
+  repository              = github_repository.test.name
+  branch                  = github_repository.test.default_branch
+  enforce_admins          = true
+  required_linear_history = true
+  required_signatures     = true
+
+  required_pull_request_reviews = {
+    dismiss_stale_reviews           = true
+    required_approving_review_count = 2
+  }
+
+  required_status_checks = {
+    strict = true
+    checks = [
+      { context = "test" },
+      { context = "lint" },
+    ]
+  }
+
+  restrictions = {
+    teams = [github_team.test.slug]
+    users = ["%[4]s"]
+  }
+}
+`, accTestConfigData.Values.Organization, repoName, teamName, accTestConfigData.Values.Username),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("enforce_admins"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_linear_history"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_pull_request_reviews").AtMapKey("required_approving_review_count"), knownvalue.Int64Exact(2)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_signatures"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_status_checks").AtMapKey("checks"), knownvalue.SetSizeExact(2)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("restrictions").AtMapKey("apps"), knownvalue.SetSizeExact(0)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("restrictions").AtMapKey("teams"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact(teamName)})),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("restrictions").AtMapKey("users"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact(accTestConfigData.Values.Username)})),
+					},
+				},
+				{
+					Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  organization = "%s"
+  name         = "%s"
+  auto_init    = true
+}
+
+resource "github_team" "test" {
+  organization = "%[1]s"
+  name         = "%[3]s"
+}
+
+resource "github_branch_protection" "test" {
+  organization = "%[1]s"
+  repository   = github_repository.test.name
+  branch       = github_repository.test.default_branch
+
+  required_pull_request_reviews = {
+    require_code_owner_reviews = true
+  }
+}
+`, accTestConfigData.Values.Organization, repoName, teamName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("enforce_admins"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_pull_request_reviews").AtMapKey("require_code_owner_reviews"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_pull_request_reviews").AtMapKey("required_approving_review_count"), knownvalue.Int64Exact(1)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_signatures"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("required_status_checks"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("github_branch_protection.test", tfjsonpath.New("restrictions"), knownvalue.Null()),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("invalid_review_count", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_branch_protection" "test" {
+  organization = "%s"
+  repository   = "test"
+  branch       = "main"
+
+  required_pull_request_reviews = {
+    required_approving_review_count = 7
+  }
+}
+`, accTestConfigData.Values.Organization),
+					ExpectError: regexp.MustCompile(`value\s+must\s+be\s+between\s+0\s+and\s+6`),
+				},
+			},
+		})
+	})
+}
+
+func TestToBranchProtectionModel(t *testing.T) {
+	known := BranchProtectionModel{
+		Branch:       types.StringValue("main"),
+		Organization: types.StringValue("test-org"),
+		Repository:   types.StringValue("test-repo"),
+		RequiredStatusChecks: &BranchProtectionStatusChecksModel{
+			Checks: []BranchProtectionStatusCheckModel{
+				{AppID: types.Int64Null(), Context: types.StringValue("lint")},
+				{AppID: types.Int64Value(15368), Context: types.StringValue("test")},
+			},
+		},
+		Restrictions: &BranchProtectionRestrictionsModel{
+			Teams: []string{"Octo-Team"},
+			Users: []string{"Octocat"},
+		},
+	}
+
+	p := &github.Protection{
+		EnforceAdmins:      &github.AdminEnforcement{Enabled: true},
+		RequiredSignatures: &github.SignaturesProtectedBranch{Enabled: github.Ptr(true)},
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Strict: true,
+			Checks: &[]*github.RequiredStatusCheck{
+				{AppID: github.Ptr(int64(15368)), Context: "lint"},
+				{AppID: github.Ptr(int64(15368)), Context: "test"},
+			},
+		},
+		Restrictions: &github.BranchRestrictions{
+			Apps:  []*github.App{},
+			Teams: []*github.Team{{Slug: github.Ptr("another-team")}, {Slug: github.Ptr("octo-team")}},
+			Users: []*github.User{{Login: github.Ptr("hubot")}, {Login: github.Ptr("octocat")}},
+		},
+	}
+
+	got := toBranchProtectionModel(known, p)
+
+	if !got.EnforceAdmins.ValueBool() || !got.RequiredSignatures.ValueBool() || got.AllowDeletions.ValueBool() {
+		t.Fatalf("unexpected flags: %+v", got)
+	}
+
+	wantChecks := []BranchProtectionStatusCheckModel{
+		{AppID: types.Int64Null(), Context: types.StringValue("lint")},
+		{AppID: types.Int64Value(15368), Context: types.StringValue("test")},
+	}
+	if !reflect.DeepEqual(got.RequiredStatusChecks.Checks, wantChecks) {
+		t.Fatalf("expected checks %v, got %v", wantChecks, got.RequiredStatusChecks.Checks)
+	}
+
+	wantRestrictions := &BranchProtectionRestrictionsModel{
+		Apps:  []string{},
+		Teams: []string{"another-team", "Octo-Team"},
+		Users: []string{"hubot", "Octocat"},
+	}
+	if !reflect.DeepEqual(got.Restrictions, wantRestrictions) {
+		t.Fatalf("expected restrictions %+v, got %+v", wantRestrictions, got.Restrictions)
+	}
+
+	if got.RequiredPullRequestReviews != nil {
+		t.Fatalf("expected no required pull request reviews, got %+v", got.RequiredPullRequestReviews)
+	}
+}
+
+func TestBranchProtectionResourceImportState(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		id         string
+		wantOrg    string
+		wantRepo   string
+		wantBranch string
+		wantErr    bool
+	}{
+		{name: "valid", id: "test-org/test-repo:main", wantOrg: "test-org", wantRepo: "test-repo", wantBranch: "main"},
+		{name: "nested_branch", id: "test-org/test-repo:release/v1", wantOrg: "test-org", wantRepo: "test-repo", wantBranch: "release/v1"},
+		{name: "missing_branch", id: "test-org/test-repo", wantErr: true},
+		{name: "empty_branch", id: "test-org/test-repo:", wantErr: true},
+		{name: "missing_repository", id: "test-org:main", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &BranchProtectionResource{}
+			resp := &fwresource.ImportStateResponse{State: testResourceState(t, r, nil)}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			var org, repo, branch types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization"), &org)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("repository"), &repo)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("branch"), &branch)...)
+			if org.ValueString() != tc.wantOrg || repo.ValueString() != tc.wantRepo || branch.ValueString() != tc.wantBranch {
+				t.Fatalf("expected %s/%s:%s, got %s/%s:%s", tc.wantOrg, tc.wantRepo, tc.wantBranch, org.ValueString(), repo.ValueString(), branch.ValueString())
+			}
+		})
+	}
+}
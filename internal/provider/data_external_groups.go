@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ datasource.DataSource              = &ExternalGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ExternalGroupsDataSource{}
+)
+
+// NewExternalGroupsDataSource creates a new external groups data source.
+func NewExternalGroupsDataSource() datasource.DataSource {
+	return &ExternalGroupsDataSource{}
+}
+
+// ExternalGroupsDataSource defines the data source implementation.
+type ExternalGroupsDataSource struct {
+	providerData *GitHubProviderData
+}
+
+// ExternalGroupsModel describes the data model.
+type ExternalGroupsModel struct {
+	DisplayName    types.String         `tfsdk:"display_name"`
+	ExternalGroups []ExternalGroupModel `tfsdk:"external_groups"`
+	Organization   types.String         `tfsdk:"organization"`
+}
+
+// ExternalGroupModel describes the data model.
+type ExternalGroupModel struct {
+	GroupID   types.Int64  `tfsdk:"group_id"`
+	GroupName types.String `tfsdk:"group_name"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source metadata.
+func (d *ExternalGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_external_groups", req.ProviderTypeName)
+}
+
+// Schema returns the data source schema.
+func (d *ExternalGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ external groups data source (`github_external_groups`) allows you to retrieve information about the external groups provisioned by the identity provider for a _GitHub_ organization in an enterprise with managed users.",
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "If set, only external groups with a name containing this value are returned.",
+				Optional:            true,
+			},
+			"external_groups": schema.ListNestedAttribute{
+				MarkdownDescription: "List of external groups.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the external group.",
+							Computed:            true,
+						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the external group.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Time the external group was last updated, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *ExternalGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected data source provider data.", fmt.Sprintf("expected *provider.GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	d.providerData = providerData
+}
+
+// Read reads the data source.
+func (d *ExternalGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExternalGroupsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.providerData.ClientCreator.OrganizationClient(ctx, data.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	groups, err := ghutil.ListAll(func(opts github.ListOptions) ([]*github.ExternalGroup, *github.Response, error) {
+		list, resp, err := client.Teams.ListExternalGroups(ctx, data.Organization.ValueString(), &github.ListExternalGroupsOptions{DisplayName: data.DisplayName.ValueStringPointer(), ListOptions: opts})
+		if err != nil {
+			return nil, resp, err
+		}
+		return list.Groups, resp, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get external groups.", err.Error())
+		return
+	}
+
+	data.ExternalGroups = make([]ExternalGroupModel, 0, len(groups))
+	for _, g := range groups {
+		m := ExternalGroupModel{
+			GroupID:   types.Int64Value(g.GetGroupID()),
+			GroupName: types.StringValue(g.GetGroupName()),
+			UpdatedAt: types.StringNull(),
+		}
+		if g.UpdatedAt != nil {
+			m.UpdatedAt = types.StringValue(g.GetUpdatedAt().Format(time.RFC3339))
+		}
+
+		data.ExternalGroups = append(data.ExternalGroups, m)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccExternalGroupsDataSource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization || !accTestConfigData.Features.ExternalGroups {
+		t.Skip("Skipping test because the external groups testing feature isn't enabled")
+	}
+
+	t.Run("display_name", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+data "github_external_groups" "test" {
+  organization = "%s"
+  display_name = "%s"
+}
+`, accTestConfigData.Values.Organization, accTestConfigData.Values.ExternalGroup),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.github_external_groups.test", tfjsonpath.New("external_groups").AtSliceIndex(0).AtMapKey("group_id"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("data.github_external_groups.test", tfjsonpath.New("external_groups").AtSliceIndex(0).AtMapKey("group_name"), knownvalue.StringExact(accTestConfigData.Values.ExternalGroup)),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("no_match", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+data "github_external_groups" "test" {
+  organization = "%s"
+  display_name = "%s"
+}
+`, accTestConfigData.Values.Organization, accTestConfigData.ResourcePrefix+"missing"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.github_external_groups.test", tfjsonpath.New("external_groups"), knownvalue.ListSizeExact(0)),
+					},
+				},
+			},
+		})
+	})
+}
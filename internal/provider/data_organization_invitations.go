@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ datasource.DataSource              = &OrganizationInvitationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &OrganizationInvitationsDataSource{}
+)
+
+// NewOrganizationInvitationsDataSource creates a new organization invitations data source.
+func NewOrganizationInvitationsDataSource() datasource.DataSource {
+	return &OrganizationInvitationsDataSource{}
+}
+
+// OrganizationInvitationsDataSource defines the data source implementation.
+type OrganizationInvitationsDataSource struct {
+	providerData *GitHubProviderData
+}
+
+// OrganizationInvitationsModel describes the data model.
+type OrganizationInvitationsModel struct {
+	Invitations  []OrganizationInvitationModel `tfsdk:"invitations"`
+	Organization types.String                  `tfsdk:"organization"`
+}
+
+// OrganizationInvitationModel describes the data model.
+type OrganizationInvitationModel struct {
+	CreatedAt types.String `tfsdk:"created_at"`
+	Email     types.String `tfsdk:"email"`
+	ID        types.Int64  `tfsdk:"id"`
+	Inviter   types.String `tfsdk:"inviter"`
+	Role      types.String `tfsdk:"role"`
+	TeamCount types.Int64  `tfsdk:"team_count"`
+	Username  types.String `tfsdk:"username"`
+}
+
+// Metadata returns the data source metadata.
+func (d *OrganizationInvitationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_organization_invitations", req.ProviderTypeName)
+}
+
+// Schema returns the data source schema.
+func (d *OrganizationInvitationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ organization invitations data source (`github_organization_invitations`) allows you to retrieve information about the pending invitations to a _GitHub_ organization.",
+		Attributes: map[string]schema.Attribute{
+			"invitations": schema.ListNestedAttribute{
+				MarkdownDescription: "List of pending invitations.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time the invitation was created, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email address that was invited, if the invitation was by email.",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the invitation.",
+							Computed:            true,
+						},
+						"inviter": schema.StringAttribute{
+							MarkdownDescription: "Login of the user who created the invitation.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role the invitation grants. Can be `admin`, `member`, `billing_manager`, `hiring_manager` or `reinstate`.",
+							Computed:            true,
+						},
+						"team_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of teams the invitation adds the user to.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Login of the user who was invited, if the invitation was for an existing user.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *OrganizationInvitationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected data source provider data.", fmt.Sprintf("expected *provider.GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	d.providerData = providerData
+}
+
+// Read reads the data source.
+func (d *OrganizationInvitationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationInvitationsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.providerData.ClientCreator.OrganizationClient(ctx, data.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	invitations, err := ghutil.ListAll(func(opts github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+		return client.Organizations.ListPendingOrgInvitations(ctx, data.Organization.ValueString(), &opts)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get organization invitations.", err.Error())
+		return
+	}
+
+	data.Invitations = make([]OrganizationInvitationModel, 0, len(invitations))
+	for _, inv := range invitations {
+		data.Invitations = append(data.Invitations, toOrganizationInvitationModel(inv))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toOrganizationInvitationModel(inv *github.Invitation) OrganizationInvitationModel {
+	m := OrganizationInvitationModel{
+		CreatedAt: types.StringNull(),
+		Email:     types.StringPointerValue(inv.Email),
+		ID:        types.Int64Value(inv.GetID()),
+		Inviter:   types.StringNull(),
+		Role:      types.StringValue(membershipRole(inv.GetRole())),
+		TeamCount: types.Int64Value(int64(inv.GetTeamCount())),
+		Username:  types.StringPointerValue(inv.Login),
+	}
+
+	if inv.CreatedAt != nil {
+		m.CreatedAt = types.StringValue(inv.GetCreatedAt().Format(time.RFC3339))
+	}
+	if inv.Inviter != nil {
+		m.Inviter = types.StringValue(inv.Inviter.GetLogin())
+	}
+
+	return m
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganizationInvitationsDataSource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("invitation", func(t *testing.T) {
+		email := fmt.Sprintf("%s%s@example.com", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_organization_membership" "test" {
+  organization = "%s"
+  email        = "%s"
+  role         = "admin"
+}
+
+data "github_organization_invitations" "test" {
+  organization = "%[1]s"
+
+  depends_on = [github_organization_membership.test]
+}
+`, accTestConfigData.Values.Organization, email),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.github_organization_invitations.test", tfjsonpath.New("invitations"), knownvalue.NotNull()),
+					},
+					Check: resource.TestCheckTypeSetElemNestedAttrs("data.github_organization_invitations.test", "invitations.*", map[string]string{
+						"email": email,
+						"role":  "admin",
+					}),
+				},
+			},
+		})
+	})
+}
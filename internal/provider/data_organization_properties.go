@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
 )
 
 var (
@@ -119,7 +121,7 @@ func (d *OrganizationPropertiesDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	cp, _, err := client.Organizations.GetAllCustomProperties(ctx, organization)
+	cp, err := ghutil.ListCustomProperties(ctx, client, organization)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get organization properties.", err.Error())
 		return
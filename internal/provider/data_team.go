@@ -24,6 +24,21 @@ type TeamDataSource struct {
 	providerData *GitHubProviderData
 }
 
+// TeamDataSourceModel describes the team data source data model.
+type TeamDataSourceModel struct {
+	CodeReviewAssignment        *TeamCodeReviewAssignmentModel `tfsdk:"code_review_assignment"`
+	Description                 types.String                   `tfsdk:"description"`
+	ID                          types.Int64                    `tfsdk:"id"`
+	IncludeCodeReviewAssignment types.Bool                     `tfsdk:"include_code_review_assignment"`
+	LDAPDN                      types.String                   `tfsdk:"ldap_dn"`
+	Name                        types.String                   `tfsdk:"name"`
+	Notifications               types.Bool                     `tfsdk:"notifications"`
+	Organization                types.String                   `tfsdk:"organization"`
+	Parent                      *TeamModel                     `tfsdk:"parent"`
+	Privacy                     types.String                   `tfsdk:"privacy"`
+	Slug                        types.String                   `tfsdk:"slug"`
+}
+
 // Metadata returns the data source metadata.
 func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = fmt.Sprintf("%s_team", req.ProviderTypeName)
@@ -34,6 +49,24 @@ func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The _GitHub_ team data source (`github_team`) allows you to retrieve information about a _GitHub_ team.",
 		Attributes: map[string]schema.Attribute{
+			"code_review_assignment": schema.SingleNestedAttribute{
+				MarkdownDescription: "Code review assignment settings of the team, this is null if code review assignment isn't enabled or `include_code_review_assignment` isn't set.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"algorithm": schema.StringAttribute{
+						MarkdownDescription: "Algorithm used to choose the reviewers. This can be one of `round_robin` or `load_balance`.",
+						Computed:            true,
+					},
+					"member_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of team members to assign to each review request.",
+						Computed:            true,
+					},
+					"notify_team": schema.BoolAttribute{
+						MarkdownDescription: "If the entire team is notified when review requests are assigned to team members.",
+						Computed:            true,
+					},
+				},
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the team",
 				Computed:            true,
@@ -42,6 +75,14 @@ func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "Unique identifier of the team",
 				Computed:            true,
 			},
+			"include_code_review_assignment": schema.BoolAttribute{
+				MarkdownDescription: "If the code review assignment settings of the team are read, these are only available through the _GitHub_ GraphQL API so they aren't read by default.",
+				Optional:            true,
+			},
+			"ldap_dn": schema.StringAttribute{
+				MarkdownDescription: "Distinguished name of the LDAP entry the team is synchronized with, this is only supported by _GitHub Enterprise Server_.",
+				Computed:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the team.",
 				Computed:            true,
@@ -101,7 +142,7 @@ func (d *TeamDataSource) Configure(ctx context.Context, req datasource.Configure
 
 // Read reads the data source.
 func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data TeamModel
+	var data TeamDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -121,12 +162,17 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	data.Description = types.StringValue(t.GetDescription())
 	data.ID = types.Int64Value(t.GetID())
+	data.LDAPDN = types.StringNull()
 	data.Name = types.StringValue(t.GetName())
 	data.Notifications = types.BoolValue(t.GetNotificationSetting() == TeamNotificationsEnabled)
 	data.Organization = types.StringValue(t.GetOrganization().GetLogin())
 	data.Privacy = types.StringValue(t.GetPrivacy())
 	data.Slug = types.StringValue(t.GetSlug())
 
+	if len(t.GetLDAPDN()) != 0 {
+		data.LDAPDN = types.StringValue(t.GetLDAPDN())
+	}
+
 	if parent := t.GetParent(); parent != nil {
 		data.Parent = &TeamModel{
 			ID:   types.Int64Value(parent.GetID()),
@@ -135,5 +181,13 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	}
 
+	if data.IncludeCodeReviewAssignment.ValueBool() {
+		data.CodeReviewAssignment, err = readTeamCodeReviewAssignment(ctx, client, data.Organization.ValueString(), data.Slug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get team code review assignment.", err.Error())
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
 )
 
 var (
@@ -106,35 +108,36 @@ func (d *TeamMembersDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	mt, _, err := client.Teams.ListTeamMembersBySlug(ctx, data.Organization.ValueString(), data.Team.ValueString(), &github.TeamListTeamMembersOptions{Role: "maintainer"})
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to get team maintainers.", err.Error())
-		return
-	}
-
-	mb, _, err := client.Teams.ListTeamMembersBySlug(ctx, data.Organization.ValueString(), data.Team.ValueString(), &github.TeamListTeamMembersOptions{Role: "member"})
+	members, err := listTeamMembers(ctx, client, data.Organization.ValueString(), data.Team.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get team members.", err.Error())
 		return
 	}
 
-	members := make([]TeamMemberModel, 0, len(mt)+len(mb))
+	data.Members = members
 
-	for _, user := range mt {
-		members = append(data.Members, TeamMemberModel{
-			Role:     types.StringValue("maintainer"),
-			Username: types.StringValue(user.GetLogin()),
-		})
-	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listTeamMembers returns the active members of a team, maintainers are listed before members.
+func listTeamMembers(ctx context.Context, client *github.Client, organization, team string) ([]TeamMemberModel, error) {
+	var members []TeamMemberModel
 
-	for _, user := range mb {
-		members = append(data.Members, TeamMemberModel{
-			Role:     types.StringValue("member"),
-			Username: types.StringValue(user.GetLogin()),
+	for _, role := range []string{"maintainer", "member"} {
+		users, err := ghutil.ListAll(func(opts github.ListOptions) ([]*github.User, *github.Response, error) {
+			return client.Teams.ListTeamMembersBySlug(ctx, organization, team, &github.TeamListTeamMembersOptions{Role: role, ListOptions: opts})
 		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list team %ss: %w", role, err)
+		}
+
+		for _, user := range users {
+			members = append(members, TeamMemberModel{
+				Role:     types.StringValue(role),
+				Username: types.StringValue(user.GetLogin()),
+			})
+		}
 	}
 
-	data.Members = members
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return emptyIfNil(members), nil
 }
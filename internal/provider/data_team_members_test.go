@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/go-github/v74/github"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghfake"
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
 )
 
 func TestAccTeamMembersDataSource(t *testing.T) {
@@ -79,3 +84,46 @@ data "github_team_members" "test" {
 		})
 	})
 }
+
+func TestListTeamMembers(t *testing.T) {
+	srv := ghfake.NewServer("octocat")
+	t.Cleanup(srv.Close)
+
+	srv.AddOrganization(github.Organization{Login: github.Ptr("test-org")})
+	if _, err := srv.AddTeam("test-org", github.NewTeam{Name: "test-team"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range 150 {
+		login := fmt.Sprintf("user-%03d", i)
+		srv.AddUser(github.User{Login: github.Ptr(login)})
+		if err := srv.AddOrganizationMember("test-org", login, "member"); err != nil {
+			t.Fatal(err)
+		}
+		if err := srv.AddTeamMember("test-org", "test-team", login, "member"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client, err := ghutil.NewGitHubClient(github.Ptr("test"), ghutil.ClientOptions{BaseURL: srv.URL()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members, err := listTeamMembers(t.Context(), client, "test-org", "test-team")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(members) != 151 {
+		t.Fatalf("expected 151 members, got %d", len(members))
+	}
+
+	if got := members[0]; got.Username.ValueString() != "octocat" || got.Role.ValueString() != "maintainer" {
+		t.Fatalf("expected the maintainer to be listed first, got %s (%s)", got.Username.ValueString(), got.Role.ValueString())
+	}
+
+	if got := members[150].Username.ValueString(); got != "user-149" {
+		t.Fatalf("expected the last member to be user-149, got %s", got)
+	}
+}
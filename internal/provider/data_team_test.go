@@ -33,6 +33,21 @@ data "github_team" "test" {
 }
 `, accTestConfigData.Values.Organization, accTestConfigData.Values.TeamSlug),
 					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.github_team.test", tfjsonpath.New("code_review_assignment"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("data.github_team.test", tfjsonpath.New("ldap_dn"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("data.github_team.test", tfjsonpath.New("name"), knownvalue.StringExact(accTestConfigData.Values.TeamSlug)),
+					},
+				},
+				{
+					Config: fmt.Sprintf(`
+data "github_team" "test" {
+  organization                   = "%s"
+  slug                           = "%s"
+  include_code_review_assignment = true
+}
+`, accTestConfigData.Values.Organization, accTestConfigData.Values.TeamSlug),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.github_team.test", tfjsonpath.New("code_review_assignment"), knownvalue.Null()),
 						statecheck.ExpectKnownValue("data.github_team.test", tfjsonpath.New("name"), knownvalue.StringExact(accTestConfigData.Values.TeamSlug)),
 					},
 				},
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &AppInstallationTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &AppInstallationTokenEphemeralResource{}
+)
+
+// NewAppInstallationTokenEphemeralResource creates a new app installation token ephemeral resource.
+func NewAppInstallationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AppInstallationTokenEphemeralResource{}
+}
+
+// AppInstallationTokenEphemeralResource defines the ephemeral resource implementation.
+type AppInstallationTokenEphemeralResource struct {
+	providerData *GitHubProviderData
+}
+
+// AppInstallationTokenModel describes the ephemeral resource data model.
+type AppInstallationTokenModel struct {
+	ExpiresAt      types.String            `tfsdk:"expires_at"`
+	InstallationID types.Int64             `tfsdk:"installation_id"`
+	Organization   types.String            `tfsdk:"organization"`
+	Permissions    map[string]types.String `tfsdk:"permissions"`
+	Repositories   []types.String          `tfsdk:"repositories"`
+	Token          types.String            `tfsdk:"token"`
+}
+
+// Metadata returns the ephemeral resource metadata.
+func (r *AppInstallationTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_app_installation_token", req.ProviderTypeName)
+}
+
+// Schema returns the ephemeral resource schema.
+func (r *AppInstallationTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ app installation token ephemeral resource (`github_app_installation_token`) allows you to create a short-lived installation token for the organization installation of the _GitHub_ app that the provider is authenticated as, without the token being stored in the _Terraform_ state. The provider must be configured with `app_auth`.",
+		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The time at which the token expires, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"installation_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the app installation that issued the token.",
+				Computed:            true,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization whose app installation should issue the token.",
+				Required:            true,
+			},
+			"permissions": schema.MapAttribute{
+				MarkdownDescription: "The permissions to request for the token, as a map of permission names such as `contents` or `members` to the access level (`read`, `write` or `admin`). If this isn't set the token has all of the installation permissions.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf("read", "write", "admin")),
+				},
+			},
+			"repositories": schema.SetAttribute{
+				MarkdownDescription: "The names of the organization repositories that the token can access. If this isn't set the token can access all of the installation repositories.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The installation token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// Configure configures the ephemeral resource.
+func (r *AppInstallationTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected ephemeral resource provider data.", fmt.Sprintf("expected *provider.GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// Open creates the installation token.
+func (r *AppInstallationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AppInstallationTokenModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope, diags := tokenScope(data.Permissions, data.Repositories, path.Root("permissions"))
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.AppClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create app client", fmt.Sprintf("The provider must be configured with app_auth to create installation tokens: %s", err.Error()))
+		return
+	}
+
+	inst, _, err := client.Apps.FindOrganizationInstallation(ctx, data.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get organization installation.", err.Error())
+		return
+	}
+
+	token, err := ghutil.CreateInstallationToken(ctx, client, inst.GetID(), scope)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create installation token.", err.Error())
+		return
+	}
+
+	data.ExpiresAt = types.StringValue(token.GetExpiresAt().Format(time.RFC3339))
+	data.InstallationID = types.Int64Value(inst.GetID())
+	data.Token = types.StringValue(token.GetToken())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghfake"
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+func TestAccAppInstallationTokenEphemeralResource(t *testing.T) {
+	if accTestConfigData.AuthType != accAuthTypeGitHubApp || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because GitHub app auth and the organization testing feature aren't enabled")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"github": testAccProtoV6ProviderFactories["github"],
+			"echo":   echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+ephemeral "github_app_installation_token" "test" {
+  organization = "%s"
+
+  permissions = {
+    metadata = "read"
+  }
+}
+
+provider "echo" {
+  data = {
+    permissions = ephemeral.github_app_installation_token.test.permissions
+    has_token   = length(ephemeral.github_app_installation_token.test.token) > 0
+  }
+}
+
+resource "echo" "test" {}
+`, accTestConfigData.Values.Organization),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("has_token"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("permissions").AtMapKey("metadata"), knownvalue.StringExact("read")),
+				},
+			},
+		},
+	})
+}
+
+func TestAppInstallationTokenEphemeralResourceOpen(t *testing.T) {
+	srv := ghfake.NewServer("octocat")
+	t.Cleanup(srv.Close)
+
+	srv.AddOrganization(github.Organization{Login: github.Ptr("test-org")})
+	if _, err := srv.AddRepository("test-org", github.Repository{Name: github.Ptr("test-repo")}); err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	appCreator, err := ghutil.NewAppClientCreator(1, ghutil.AppKey{PEM: privateKey}, 0, "", ghutil.TokenScope{}, 10, ghutil.ClientOptions{BaseURL: srv.URL()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenCreator, err := ghutil.NewClientCreator(github.Ptr("test"), ghutil.ClientOptions{BaseURL: srv.URL()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		cc      ghutil.ClientCreator
+		model   AppInstallationTokenModel
+		wantErr string
+	}{
+		{
+			name:  "organization",
+			cc:    appCreator,
+			model: AppInstallationTokenModel{Organization: types.StringValue("test-org")},
+		},
+		{
+			name: "scoped",
+			cc:   appCreator,
+			model: AppInstallationTokenModel{
+				Organization: types.StringValue("test-org"),
+				Permissions:  map[string]types.String{"contents": types.StringValue("read")},
+				Repositories: []types.String{types.StringValue("test-repo")},
+			},
+		},
+		{
+			name: "missing_repository",
+			cc:   appCreator,
+			model: AppInstallationTokenModel{
+				Organization: types.StringValue("test-org"),
+				Repositories: []types.String{types.StringValue("missing-repo")},
+			},
+			wantErr: "Failed to create installation token.",
+		},
+		{
+			name: "unknown_permission",
+			cc:   appCreator,
+			model: AppInstallationTokenModel{
+				Organization: types.StringValue("test-org"),
+				Permissions:  map[string]types.String{"everything": types.StringValue("read")},
+			},
+			wantErr: "Invalid token permissions.",
+		},
+		{
+			name:    "missing_organization",
+			cc:      appCreator,
+			model:   AppInstallationTokenModel{Organization: types.StringValue("missing-org")},
+			wantErr: "Failed to get organization installation.",
+		},
+		{
+			name:    "token_auth",
+			cc:      tokenCreator,
+			model:   AppInstallationTokenModel{Organization: types.StringValue("test-org")},
+			wantErr: "Failed to create app client",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &AppInstallationTokenEphemeralResource{providerData: &GitHubProviderData{ClientCreator: tc.cc}}
+
+			schemaResp := &ephemeral.SchemaResponse{}
+			r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+			raw := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			if diags := raw.Set(ctx, &tc.model); diags.HasError() {
+				t.Fatalf("unexpected config diagnostics: %v", diags)
+			}
+
+			req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw.Raw}}
+			resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+			r.Open(ctx, req, resp)
+
+			if len(tc.wantErr) != 0 {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tc.wantErr {
+					t.Fatalf("expected error %q, got: %v", tc.wantErr, resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var result AppInstallationTokenModel
+			if diags := resp.Result.Get(ctx, &result); diags.HasError() {
+				t.Fatalf("unexpected result diagnostics: %v", diags)
+			}
+
+			if len(result.Token.ValueString()) == 0 {
+				t.Error("expected a token")
+			}
+			if result.InstallationID.IsNull() || len(result.ExpiresAt.ValueString()) == 0 {
+				t.Errorf("expected the installation ID and expiry, got %v and %v", result.InstallationID, result.ExpiresAt)
+			}
+		})
+	}
+}
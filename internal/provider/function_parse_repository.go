@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var _ function.Function = &ParseRepositoryFunction{}
+
+// NewParseRepositoryFunction creates a new parse repository function.
+func NewParseRepositoryFunction() function.Function {
+	return &ParseRepositoryFunction{}
+}
+
+// ParseRepositoryFunction defines the function implementation.
+type ParseRepositoryFunction struct{}
+
+// ParseRepositoryModel describes the function result.
+type ParseRepositoryModel struct {
+	Name  types.String `tfsdk:"name"`
+	Owner types.String `tfsdk:"owner"`
+}
+
+// Metadata returns the function metadata.
+func (f *ParseRepositoryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_repository"
+}
+
+// Definition returns the function definition.
+func (f *ParseRepositoryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses a repository full name into the owner and the name.",
+		MarkdownDescription: "The _GitHub_ parse repository function (`parse_repository`) parses a repository full name in the format `owner/name` and returns an object with the `owner` and `name` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "full_name",
+				MarkdownDescription: "Full name of the repository in the format `owner/name`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"name":  types.StringType,
+				"owner": types.StringType,
+			},
+		},
+	}
+}
+
+// Run runs the function.
+func (f *ParseRepositoryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var fullName string
+	if resp.Error = req.Arguments.Get(ctx, &fullName); resp.Error != nil {
+		return
+	}
+
+	owner, name, err := ghutil.ParseRepository(fullName)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid repository full name: %s", err.Error()))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, ParseRepositoryModel{
+		Name:  types.StringValue(name),
+		Owner: types.StringValue(owner),
+	})
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseRepositoryFunction(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		input     string
+		wantOwner string
+		wantName  string
+		wantErr   bool
+	}{
+		{name: "valid", input: "octocat/hello-world", wantOwner: "octocat", wantName: "hello-world"},
+		{name: "dots", input: "octo-org/octo.github.io", wantOwner: "octo-org", wantName: "octo.github.io"},
+		{name: "no_owner", input: "hello-world", wantErr: true},
+		{name: "empty_owner", input: "/hello-world", wantErr: true},
+		{name: "empty_name", input: "octocat/", wantErr: true},
+		{name: "too_many_parts", input: "octocat/hello-world/main", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.input)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(map[string]attr.Type{"name": types.StringType, "owner": types.StringType}))}
+
+			NewParseRepositoryFunction().Run(context.Background(), req, resp)
+
+			if tc.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %v", resp.Result.Value())
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			attrs := resp.Result.Value().(types.Object).Attributes()
+			if owner := attrs["owner"].(types.String).ValueString(); owner != tc.wantOwner {
+				t.Errorf("expected owner %q, got %q", tc.wantOwner, owner)
+			}
+			if name := attrs["name"].(types.String).ValueString(); name != tc.wantName {
+				t.Errorf("expected name %q, got %q", tc.wantName, name)
+			}
+		})
+	}
+}
+
+func TestAccParseRepositoryFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::github::parse_repository("octocat/hello-world")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"name":  knownvalue.StringExact("hello-world"),
+						"owner": knownvalue.StringExact("octocat"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::github::parse_repository("hello-world")
+}
+`,
+				ExpectError: regexp.MustCompile(`must be in the format\s+"owner/name"`),
+			},
+		},
+	})
+}
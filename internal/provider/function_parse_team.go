@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var _ function.Function = &ParseTeamFunction{}
+
+// NewParseTeamFunction creates a new parse team function.
+func NewParseTeamFunction() function.Function {
+	return &ParseTeamFunction{}
+}
+
+// ParseTeamFunction defines the function implementation.
+type ParseTeamFunction struct{}
+
+// ParseTeamModel describes the function result.
+type ParseTeamModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Slug         types.String `tfsdk:"slug"`
+}
+
+// Metadata returns the function metadata.
+func (f *ParseTeamFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_team"
+}
+
+// Definition returns the function definition.
+func (f *ParseTeamFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses a team identifier into the organization and the team slug.",
+		MarkdownDescription: "The _GitHub_ parse team function (`parse_team`) parses a team identifier in either the `organization:team_slug` format used by the provider import IDs or the `@organization/team_slug` format used by _GitHub_ mentions and `CODEOWNERS` files, and returns an object with the `organization` and `slug` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "Identifier of the team in the format `organization:team_slug` or `@organization/team_slug`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"organization": types.StringType,
+				"slug":         types.StringType,
+			},
+		},
+	}
+}
+
+// Run runs the function.
+func (f *ParseTeamFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	if resp.Error = req.Arguments.Get(ctx, &id); resp.Error != nil {
+		return
+	}
+
+	organization, slug, err := ghutil.ParseTeam(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid team identifier: %s", err.Error()))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, ParseTeamModel{
+		Organization: types.StringValue(organization),
+		Slug:         types.StringValue(slug),
+	})
+}
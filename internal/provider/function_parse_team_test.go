@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseTeamFunction(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		input            string
+		wantOrganization string
+		wantSlug         string
+		wantErr          bool
+	}{
+		{name: "import_id", input: "octo-org:octo-team", wantOrganization: "octo-org", wantSlug: "octo-team"},
+		{name: "mention", input: "@octo-org/octo-team", wantOrganization: "octo-org", wantSlug: "octo-team"},
+		{name: "repository", input: "octo-org/octo-team", wantErr: true},
+		{name: "empty_slug", input: "octo-org:", wantErr: true},
+		{name: "empty_organization", input: "@/octo-team", wantErr: true},
+		{name: "too_many_parts", input: "octo-org:octo-team:octocat", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.input)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(map[string]attr.Type{"organization": types.StringType, "slug": types.StringType}))}
+
+			NewParseTeamFunction().Run(context.Background(), req, resp)
+
+			if tc.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %v", resp.Result.Value())
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			attrs := resp.Result.Value().(types.Object).Attributes()
+			if organization := attrs["organization"].(types.String).ValueString(); organization != tc.wantOrganization {
+				t.Errorf("expected organization %q, got %q", tc.wantOrganization, organization)
+			}
+			if slug := attrs["slug"].(types.String).ValueString(); slug != tc.wantSlug {
+				t.Errorf("expected slug %q, got %q", tc.wantSlug, slug)
+			}
+		})
+	}
+}
+
+func TestAccParseTeamFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::github::parse_team("@octo-org/octo-team")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact("octo-org"),
+						"slug":         knownvalue.StringExact("octo-team"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::github::parse_team("octo-org:")
+}
+`,
+				ExpectError: regexp.MustCompile(`team_slug\s+must\s+be\s+non-empty`),
+			},
+		},
+	})
+}
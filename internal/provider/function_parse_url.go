@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var _ function.Function = &ParseURLFunction{}
+
+// NewParseURLFunction creates a new parse URL function.
+func NewParseURLFunction() function.Function {
+	return &ParseURLFunction{}
+}
+
+// ParseURLFunction defines the function implementation.
+type ParseURLFunction struct{}
+
+// ParseURLModel describes the function result.
+type ParseURLModel struct {
+	Host       types.String `tfsdk:"host"`
+	Number     types.Int64  `tfsdk:"number"`
+	Owner      types.String `tfsdk:"owner"`
+	Path       types.String `tfsdk:"path"`
+	Ref        types.String `tfsdk:"ref"`
+	Repository types.String `tfsdk:"repository"`
+}
+
+// Metadata returns the function metadata.
+func (f *ParseURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_url"
+}
+
+// Definition returns the function definition.
+func (f *ParseURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parses a GitHub web or git URL.",
+		MarkdownDescription: "The _GitHub_ parse URL function (`parse_url`) parses a _GitHub_ web or git URL and returns an object with the `host`, `owner`, `repository`, `ref`, `path` and `number` attributes; attributes which aren't part of the URL are `null`. HTTPS URLs of an owner, a repository, a tree, blob or commit, or a pull request or issue are supported, as are SSH git URLs in both the `git@host:owner/repo.git` and `ssh://git@host/owner/repo.git` formats. As branch names can contain `/`, only the first path segment after `tree`, `blob` or `commit` is used as the `ref`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "URL to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"host":       types.StringType,
+				"number":     types.Int64Type,
+				"owner":      types.StringType,
+				"path":       types.StringType,
+				"ref":        types.StringType,
+				"repository": types.StringType,
+			},
+		},
+	}
+}
+
+// Run runs the function.
+func (f *ParseURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+	if resp.Error = req.Arguments.Get(ctx, &raw); resp.Error != nil {
+		return
+	}
+
+	u, err := ghutil.ParseURL(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid GitHub URL: %s", err.Error()))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, toParseURLModel(u))
+}
+
+// toParseURLModel converts a parsed GitHub URL to the function result, with null values for the parts which aren't in the URL.
+func toParseURLModel(u ghutil.GitHubURL) ParseURLModel {
+	m := ParseURLModel{
+		Host:       types.StringValue(u.Host),
+		Number:     types.Int64Null(),
+		Owner:      types.StringValue(u.Owner),
+		Path:       types.StringNull(),
+		Ref:        types.StringNull(),
+		Repository: types.StringNull(),
+	}
+
+	if len(u.Repository) != 0 {
+		m.Repository = types.StringValue(u.Repository)
+	}
+	if len(u.Ref) != 0 {
+		m.Ref = types.StringValue(u.Ref)
+	}
+	if len(u.Path) != 0 {
+		m.Path = types.StringValue(u.Path)
+	}
+	if u.Number != 0 {
+		m.Number = types.Int64Value(int64(u.Number))
+	}
+
+	return m
+}
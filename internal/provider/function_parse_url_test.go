@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseURLFunction(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"host":       types.StringType,
+		"number":     types.Int64Type,
+		"owner":      types.StringType,
+		"path":       types.StringType,
+		"ref":        types.StringType,
+		"repository": types.StringType,
+	}
+
+	for _, tc := range []struct {
+		name    string
+		input   string
+		want    ParseURLModel
+		wantErr bool
+	}{
+		{
+			name:  "owner",
+			input: "https://github.com/octocat",
+			want:  ParseURLModel{Host: types.StringValue("github.com"), Number: types.Int64Null(), Owner: types.StringValue("octocat"), Path: types.StringNull(), Ref: types.StringNull(), Repository: types.StringNull()},
+		},
+		{
+			name:  "blob",
+			input: "https://github.example.com/octocat/hello-world/blob/main/docs/README.md",
+			want:  ParseURLModel{Host: types.StringValue("github.example.com"), Number: types.Int64Null(), Owner: types.StringValue("octocat"), Path: types.StringValue("docs/README.md"), Ref: types.StringValue("main"), Repository: types.StringValue("hello-world")},
+		},
+		{
+			name:  "pull",
+			input: "https://github.com/octocat/hello-world/pull/42",
+			want:  ParseURLModel{Host: types.StringValue("github.com"), Number: types.Int64Value(42), Owner: types.StringValue("octocat"), Path: types.StringNull(), Ref: types.StringNull(), Repository: types.StringValue("hello-world")},
+		},
+		{
+			name:  "ssh",
+			input: "git@github.com:octocat/hello-world.git",
+			want:  ParseURLModel{Host: types.StringValue("github.com"), Number: types.Int64Null(), Owner: types.StringValue("octocat"), Path: types.StringNull(), Ref: types.StringNull(), Repository: types.StringValue("hello-world")},
+		},
+		{name: "unsupported_scheme", input: "ftp://github.com/octocat", wantErr: true},
+		{name: "no_owner", input: "https://github.com", wantErr: true},
+		{name: "invalid_number", input: "https://github.com/octocat/hello-world/issues/abc", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.input)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(attrTypes))}
+
+			NewParseURLFunction().Run(context.Background(), req, resp)
+
+			if tc.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %v", resp.Result.Value())
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			var got ParseURLModel
+			if diags := resp.Result.Value().(types.Object).As(context.Background(), &got, basetypes.ObjectAsOptions{}); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got != tc.want {
+				t.Fatalf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAccParseURLFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::github::parse_url("https://github.com/octocat/hello-world/tree/main/docs")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"host":       knownvalue.StringExact("github.com"),
+						"number":     knownvalue.Null(),
+						"owner":      knownvalue.StringExact("octocat"),
+						"path":       knownvalue.StringExact("docs"),
+						"ref":        knownvalue.StringExact("main"),
+						"repository": knownvalue.StringExact("hello-world"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::github::parse_url("ftp://github.com/octocat")
+}
+`,
+				ExpectError: regexp.MustCompile(`unsupported\s+URL`),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var _ function.Function = &RefToBranchFunction{}
+
+// NewRefToBranchFunction creates a new ref to branch function.
+func NewRefToBranchFunction() function.Function {
+	return &RefToBranchFunction{}
+}
+
+// RefToBranchFunction defines the function implementation.
+type RefToBranchFunction struct{}
+
+// Metadata returns the function metadata.
+func (f *RefToBranchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ref_to_branch"
+}
+
+// Definition returns the function definition.
+func (f *RefToBranchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the branch name of a git ref.",
+		MarkdownDescription: "The _GitHub_ ref to branch function (`ref_to_branch`) returns the branch name of a git ref, such as the `GITHUB_REF` of a workflow run. A fully qualified branch ref such as `refs/heads/main` has the `refs/heads/` prefix removed and a short branch name is returned unchanged; other fully qualified refs, such as tags and pull request refs, are an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ref",
+				MarkdownDescription: "Git ref of the branch.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run runs the function.
+func (f *RefToBranchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ref string
+	if resp.Error = req.Arguments.Get(ctx, &ref); resp.Error != nil {
+		return
+	}
+
+	branch, err := ghutil.RefToBranch(ref)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid branch ref: %s", err.Error()))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, branch)
+}
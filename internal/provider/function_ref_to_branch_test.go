@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestRefToBranchFunction(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "branch_ref", input: "refs/heads/main", want: "main"},
+		{name: "nested_branch_ref", input: "refs/heads/release/v1", want: "release/v1"},
+		{name: "short_name", input: "main", want: "main"},
+		{name: "tag_ref", input: "refs/tags/v1.0.0", wantErr: true},
+		{name: "pull_ref", input: "refs/pull/42/merge", wantErr: true},
+		{name: "empty_branch_ref", input: "refs/heads/", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.input)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewRefToBranchFunction().Run(context.Background(), req, resp)
+
+			if tc.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %v", resp.Result.Value())
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			if got := resp.Result.Value().(types.String).ValueString(); got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAccRefToBranchFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::github::ref_to_branch("refs/heads/feature/new")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("feature/new")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::github::ref_to_branch("refs/tags/v1.0.0")
+}
+`,
+				ExpectError: regexp.MustCompile(`isn't\s+a\s+branch`),
+			},
+		},
+	})
+}
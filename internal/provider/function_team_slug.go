@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var _ function.Function = &TeamSlugFunction{}
+
+// NewTeamSlugFunction creates a new team slug function.
+func NewTeamSlugFunction() function.Function {
+	return &TeamSlugFunction{}
+}
+
+// TeamSlugFunction defines the function implementation.
+type TeamSlugFunction struct{}
+
+// Metadata returns the function metadata.
+func (f *TeamSlugFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "team_slug"
+}
+
+// Definition returns the function definition.
+func (f *TeamSlugFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the slug of a team name.",
+		MarkdownDescription: "The _GitHub_ team slug function (`team_slug`) returns the slug that _GitHub_ generates for a team name, so that a team can be referenced before it exists. Runs of spaces and `-` are replaced with a single `-`, letters are lowercased, and leading or trailing dashes are trimmed. The slug can only be predicted for names containing ASCII letters, digits, spaces, `-` and `_`; other names return an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Name of the team.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run runs the function.
+func (f *TeamSlugFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	if resp.Error = req.Arguments.Get(ctx, &name); resp.Error != nil {
+		return
+	}
+
+	slug, ok := ghutil.TeamSlug(name)
+	if len(slug) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "The team name doesn't contain any characters that can be used in a slug")
+		return
+	}
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, "The team slug can only be predicted for names containing ASCII letters, digits, spaces, \"-\" and \"_\"")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, slug)
+}
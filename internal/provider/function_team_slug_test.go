@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestTeamSlugFunction(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "lowercase", input: "team", want: "team"},
+		{name: "spaces", input: "My Team", want: "my-team"},
+		{name: "underscore", input: "my_team", want: "my_team"},
+		{name: "leading_trailing", input: "  -Team-  ", want: "team"},
+		{name: "collapse_dashes", input: "Team -- Name", want: "team-name"},
+		{name: "punctuation", input: "platform.infra/ops", wantErr: true},
+		{name: "accents", input: "Café Équipe", wantErr: true},
+		{name: "non_latin", input: "Team 日本", wantErr: true},
+		{name: "digits", input: "Team 42", want: "team-42"},
+		{name: "no_slug_characters", input: "!!!", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.input)})}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewTeamSlugFunction().Run(context.Background(), req, resp)
+
+			if tc.wantErr {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %v", resp.Result.Value())
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			if got := resp.Result.Value().(types.String).ValueString(); got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAccTeamSlugFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::github::team_slug("Platform Team -- Ops")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("platform-team-ops")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::github::team_slug("!!!")
+}
+`,
+				ExpectError: regexp.MustCompile(`doesn't contain any\s+characters`),
+			},
+		},
+	})
+}
@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                = &OrganizationMembershipResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationMembershipResource{}
+	_ resource.ResourceWithImportState = &OrganizationMembershipResource{}
+)
+
+// NewOrganizationMembershipResource creates a new OrganizationMembershipResource.
+func NewOrganizationMembershipResource() resource.Resource {
+	return &OrganizationMembershipResource{}
+}
+
+// OrganizationMembershipResource defines the resource implementation.
+type OrganizationMembershipResource struct {
+	providerData *GitHubProviderData
+}
+
+// OrganizationMembershipModel describes the data model.
+type OrganizationMembershipModel struct {
+	ConvertToOutsideCollaboratorOnDestroy types.Bool   `tfsdk:"convert_to_outside_collaborator_on_destroy"`
+	Email                                 types.String `tfsdk:"email"`
+	InvitationID                          types.Int64  `tfsdk:"invitation_id"`
+	Organization                          types.String `tfsdk:"organization"`
+	Role                                  types.String `tfsdk:"role"`
+	State                                 types.String `tfsdk:"state"`
+	Username                              types.String `tfsdk:"username"`
+}
+
+// Metadata returns the resource metadata.
+func (r *OrganizationMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_organization_membership", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *OrganizationMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ organization membership resource (`github_organization_membership`) allows you to manage the membership of a _GitHub_ organization; users who aren't already members are invited. Once an email invitation has been accepted the user isn't known, so the membership should be managed by `username` instead.",
+		Attributes: map[string]schema.Attribute{
+			"convert_to_outside_collaborator_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, an active member will be converted to an outside collaborator, keeping their access to the organization's repositories, instead of being removed when the resource is destroyed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address to invite to the organization; exactly one of `email` or `username` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("username")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"invitation_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the invitation if the membership was created by inviting an `email`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the membership. Can be `admin` or `member`; defaults to `member`. Changing the role of an `email` invitation creates a new invitation.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("member"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						var email types.String
+						resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("email"), &email)...)
+						resp.RequiresReplace = !email.IsNull()
+					}, "If the membership was created by inviting an email the invitation is replaced.", "If the membership was created by inviting an `email` the invitation is replaced."),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "member"),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the membership. Can be `active` or `pending`; an `email` invitation which is no longer pending and hasn't failed is assumed to be `active`.",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Login of the user to add to the organization; exactly one of `email` or `username` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *OrganizationMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// Create creates the resource.
+func (r *OrganizationMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationMembershipModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	if !plan.Email.IsNull() {
+		inv, _, err := client.Organizations.CreateOrgInvitation(ctx, organization, &github.CreateOrgInvitationOptions{
+			Email: plan.Email.ValueStringPointer(),
+			Role:  github.Ptr(invitationRole(plan.Role.ValueString())),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create organization invitation.", err.Error())
+			return
+		}
+
+		plan.InvitationID = types.Int64Value(inv.GetID())
+		plan.Role = types.StringValue(membershipRole(inv.GetRole()))
+		plan.State = types.StringValue("pending")
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	username := plan.Username.ValueString()
+
+	_, _, err = client.Organizations.GetOrgMembership(ctx, username, organization)
+	if err == nil {
+		resp.Diagnostics.AddError("Organization membership already exists.", fmt.Sprintf("user %q is already a member of or invited to the organization, the membership can be imported", username))
+		return
+	}
+	if !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to get organization membership.", err.Error())
+		return
+	}
+
+	m, _, err := client.Organizations.EditOrgMembership(ctx, username, organization, &github.Membership{Role: plan.Role.ValueStringPointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization membership.", err.Error())
+		return
+	}
+
+	plan.InvitationID = types.Int64Null()
+	plan.Role = types.StringValue(m.GetRole())
+	plan.State = types.StringValue(m.GetState())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the resource state.
+func (r *OrganizationMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationMembershipModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	if !state.Email.IsNull() {
+		if state.State.ValueString() != "pending" {
+			return
+		}
+
+		inv, err := findOrganizationInvitation(ctx, client, organization, state.InvitationID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get organization invitation.", err.Error())
+			return
+		}
+		// A failed invitation has expired so needs to be recreated, and an invitation which isn't pending or failed has been
+		// accepted.
+		switch {
+		case inv == nil:
+			state.State = types.StringValue("active")
+		case inv.FailedAt != nil:
+			resp.State.RemoveResource(ctx)
+			return
+		default:
+			state.Role = types.StringValue(membershipRole(inv.GetRole()))
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	m, _, err := client.Organizations.GetOrgMembership(ctx, state.Username.ValueString(), organization)
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get organization membership.", err.Error())
+		return
+	}
+
+	state.Role = types.StringValue(m.GetRole())
+	state.State = types.StringValue(m.GetState())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource.
+func (r *OrganizationMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationMembershipModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.InvitationID = state.InvitationID
+	plan.State = state.State
+
+	// The role of an email invitation can't be changed so only the destroy behavior can be updated.
+	if !plan.Email.IsNull() || plan.Role.Equal(state.Role) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	m, _, err := client.Organizations.EditOrgMembership(ctx, plan.Username.ValueString(), organization, &github.Membership{Role: plan.Role.ValueStringPointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update organization membership.", err.Error())
+		return
+	}
+
+	plan.Role = types.StringValue(m.GetRole())
+	plan.State = types.StringValue(m.GetState())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource.
+func (r *OrganizationMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationMembershipModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	if !state.Email.IsNull() {
+		if state.State.ValueString() != "pending" {
+			resp.Diagnostics.AddWarning("Organization membership not removed.", fmt.Sprintf("the invitation for %q has been accepted but the user isn't known, so they must be removed from the organization manually", state.Email.ValueString()))
+			return
+		}
+
+		_, err = client.Organizations.CancelInvite(ctx, organization, state.InvitationID.ValueInt64())
+		if err != nil && !ghutil.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to cancel organization invitation.", err.Error())
+		}
+		return
+	}
+
+	username := state.Username.ValueString()
+
+	if state.ConvertToOutsideCollaboratorOnDestroy.ValueBool() {
+		m, _, err := client.Organizations.GetOrgMembership(ctx, username, organization)
+		if ghutil.IsNotFound(err) {
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get organization membership.", err.Error())
+			return
+		}
+
+		// Only active members can be converted, pending invitations are cancelled.
+		if m.GetState() == "active" {
+			_, err = client.Organizations.ConvertMemberToOutsideCollaborator(ctx, organization, username)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to convert member to outside collaborator.", err.Error())
+			}
+			return
+		}
+	}
+
+	_, err = client.Organizations.RemoveOrgMembership(ctx, username, organization)
+	if err != nil && !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete organization membership.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *OrganizationMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization", "username")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("convert_to_outside_collaborator_on_destroy"), false)...)
+}
+
+// findOrganizationInvitation returns the pending or failed organization invitation with the ID, or nil if there isn't one; the
+// failed invitations are only listed if there isn't a pending invitation.
+func findOrganizationInvitation(ctx context.Context, client *github.Client, organization string, id int64) (*github.Invitation, error) {
+	for inv, err := range ghutil.Paginate(func(opts github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+		return client.Organizations.ListPendingOrgInvitations(ctx, organization, &opts)
+	}) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pending organization invitations: %w", err)
+		}
+		if inv.GetID() == id {
+			return inv, nil
+		}
+	}
+
+	for inv, err := range ghutil.Paginate(func(opts github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+		return client.Organizations.ListFailedOrgInvitations(ctx, organization, &opts)
+	}) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list failed organization invitations: %w", err)
+		}
+		if inv.GetID() == id {
+			return inv, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// invitationRole returns the invitation role for a membership role, the invitations API uses "direct_member" for members.
+func invitationRole(role string) string {
+	if role == "member" {
+		return "direct_member"
+	}
+	return role
+}
+
+// membershipRole returns the membership role for an invitation role.
+func membershipRole(role string) string {
+	if role == "direct_member" {
+		return "member"
+	}
+	return role
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganizationMembershipResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("email", func(t *testing.T) {
+		email := fmt.Sprintf("%s%s@example.com", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		config := func(role string) string {
+			return fmt.Sprintf(`
+resource "github_organization_membership" "test" {
+  organization = "%s"
+  email        = "%s"
+  role         = "%s"
+}
+`, accTestConfigData.Values.Organization, email, role)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config("member"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_organization_membership.test", tfjsonpath.New("email"), knownvalue.StringExact(email)),
+						statecheck.ExpectKnownValue("github_organization_membership.test", tfjsonpath.New("invitation_id"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("github_organization_membership.test", tfjsonpath.New("role"), knownvalue.StringExact("member")),
+						statecheck.ExpectKnownValue("github_organization_membership.test", tfjsonpath.New("state"), knownvalue.StringExact("pending")),
+						statecheck.ExpectKnownValue("github_organization_membership.test", tfjsonpath.New("username"), knownvalue.Null()),
+					},
+				},
+				{
+					Config: config("admin"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("github_organization_membership.test", plancheck.ResourceActionDestroyBeforeCreate),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_organization_membership.test", tfjsonpath.New("role"), knownvalue.StringExact("admin")),
+						statecheck.ExpectKnownValue("github_organization_membership.test", tfjsonpath.New("state"), knownvalue.StringExact("pending")),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("existing_member", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_organization_membership" "test" {
+  organization = "%s"
+  username     = "%s"
+}
+`, accTestConfigData.Values.Organization, accTestConfigData.Values.Username),
+					ExpectError: regexp.MustCompile(`Organization\s+membership\s+already\s+exists`),
+				},
+			},
+		})
+	})
+
+	t.Run("email_and_username", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_organization_membership" "test" {
+  organization = "%s"
+  email        = "test@example.com"
+  username     = "%s"
+}
+`, accTestConfigData.Values.Organization, accTestConfigData.Values.Username),
+					ExpectError: regexp.MustCompile(`Invalid\s+Attribute\s+Combination`),
+				},
+			},
+		})
+	})
+}
+
+func TestOrganizationMembershipResourceRead(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		email       bool
+		state       string
+		routes      map[string]http.HandlerFunc
+		wantRemoved bool
+		wantRole    string
+		wantState   string
+	}{
+		{
+			name: "username_active",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/memberships/test-user": testMockJSON(http.StatusOK, `{"role":"admin","state":"active"}`),
+			},
+			wantRole:  "admin",
+			wantState: "active",
+		},
+		{
+			name: "username_pending",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/memberships/test-user": testMockJSON(http.StatusOK, `{"role":"member","state":"pending"}`),
+			},
+			wantRole:  "member",
+			wantState: "pending",
+		},
+		{
+			name: "username_not_found",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/memberships/test-user": testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`),
+			},
+			wantRemoved: true,
+		},
+		{
+			name:  "email_pending",
+			email: true,
+			state: "pending",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/invitations": testMockJSON(http.StatusOK, `[{"id":1,"role":"admin"}]`),
+			},
+			wantRole:  "admin",
+			wantState: "pending",
+		},
+		{
+			name:  "email_accepted",
+			email: true,
+			state: "pending",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/invitations":        testMockJSON(http.StatusOK, `[]`),
+				"GET /api/v3/orgs/test-org/failed_invitations": testMockJSON(http.StatusOK, `[]`),
+			},
+			wantRole:  "member",
+			wantState: "active",
+		},
+		{
+			name:  "email_failed",
+			email: true,
+			state: "pending",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/invitations":        testMockJSON(http.StatusOK, `[]`),
+				"GET /api/v3/orgs/test-org/failed_invitations": testMockJSON(http.StatusOK, `[{"id":1,"role":"direct_member","failed_at":"2025-01-01T00:00:00Z"}]`),
+			},
+			wantRemoved: true,
+		},
+		{
+			name:      "email_active",
+			email:     true,
+			state:     "active",
+			routes:    map[string]http.HandlerFunc{},
+			wantRole:  "member",
+			wantState: "active",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mux := http.NewServeMux()
+			for pattern, handler := range tc.routes {
+				mux.Handle(pattern, handler)
+			}
+
+			model := &OrganizationMembershipModel{
+				ConvertToOutsideCollaboratorOnDestroy: types.BoolValue(false),
+				Email:                                 types.StringNull(),
+				InvitationID:                          types.Int64Null(),
+				Organization:                          types.StringValue("test-org"),
+				Role:                                  types.StringValue("member"),
+				State:                                 types.StringValue("active"),
+				Username:                              types.StringValue("test-user"),
+			}
+			if tc.email {
+				model.Email = types.StringValue("test@example.com")
+				model.InvitationID = types.Int64Value(1)
+				model.State = types.StringValue(tc.state)
+				model.Username = types.StringNull()
+			}
+
+			r := &OrganizationMembershipResource{providerData: testMockProviderData(t, mux)}
+			state := testResourceState(t, r, model)
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Fatalf("expected removed %t, got %t", tc.wantRemoved, resp.State.Raw.IsNull())
+			}
+
+			if tc.wantRemoved {
+				return
+			}
+
+			var got OrganizationMembershipModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.Role.ValueString() != tc.wantRole || got.State.ValueString() != tc.wantState {
+				t.Fatalf("expected %s (%s), got %s (%s)", tc.wantRole, tc.wantState, got.Role.ValueString(), got.State.ValueString())
+			}
+		})
+	}
+}
+
+func TestOrganizationMembershipResourceImportState(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		id           string
+		wantOrg      string
+		wantUsername string
+		wantErr      bool
+	}{
+		{name: "valid", id: "test-org:test-user", wantOrg: "test-org", wantUsername: "test-user"},
+		{name: "missing_username", id: "test-org", wantErr: true},
+		{name: "empty_username", id: "test-org:", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &OrganizationMembershipResource{}
+			resp := &fwresource.ImportStateResponse{State: testResourceState(t, r, nil)}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			var org, username types.String
+			var convert types.Bool
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization"), &org)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("username"), &username)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("convert_to_outside_collaborator_on_destroy"), &convert)...)
+			if org.ValueString() != tc.wantOrg || username.ValueString() != tc.wantUsername || convert.ValueBool() {
+				t.Fatalf("expected %s:%s, got %s:%s (convert %t)", tc.wantOrg, tc.wantUsername, org.ValueString(), username.ValueString(), convert.ValueBool())
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -19,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
 )
 
 var (
@@ -264,25 +265,15 @@ func (r *OrganizationPropertyResource) Delete(ctx context.Context, req resource.
 
 // ImportState imports the resource state.
 func (r *OrganizationPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID.", "import id must be in the format \"organization:property_name\"")
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization", "property_name")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
 		return
 	}
 
 	organization := parts[0]
 	propertyName := parts[1]
 
-	if len(organization) == 0 {
-		resp.Diagnostics.AddError("Invalid import ID.", "organization must be non-empty")
-		return
-	}
-
-	if len(propertyName) == 0 || propertyName == "" {
-		resp.Diagnostics.AddError("Invalid import ID.", "property_name must be non-empty")
-		return
-	}
-
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), propertyName)...)
 }
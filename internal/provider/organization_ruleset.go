@@ -0,0 +1,510 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                = &OrganizationRulesetResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationRulesetResource{}
+	_ resource.ResourceWithImportState = &OrganizationRulesetResource{}
+)
+
+// NewOrganizationRulesetResource creates a new OrganizationRulesetResource.
+func NewOrganizationRulesetResource() resource.Resource {
+	return &OrganizationRulesetResource{}
+}
+
+// OrganizationRulesetResource defines the resource implementation.
+type OrganizationRulesetResource struct {
+	providerData *GitHubProviderData
+}
+
+// OrganizationRulesetModel describes the data model.
+type OrganizationRulesetModel struct {
+	BypassActors []RulesetBypassActorModel           `tfsdk:"bypass_actors"`
+	Conditions   *OrganizationRulesetConditionsModel `tfsdk:"conditions"`
+	Enforcement  types.String                        `tfsdk:"enforcement"`
+	ID           types.Int64                         `tfsdk:"id"`
+	Name         types.String                        `tfsdk:"name"`
+	Organization types.String                        `tfsdk:"organization"`
+	Rules        *RulesetRulesModel                  `tfsdk:"rules"`
+	Target       types.String                        `tfsdk:"target"`
+}
+
+// OrganizationRulesetConditionsModel describes the conditions data model.
+type OrganizationRulesetConditionsModel struct {
+	RefName            *RulesetRefNameConditionModel                        `tfsdk:"ref_name"`
+	RepositoryID       *OrganizationRulesetRepositoryIDConditionModel       `tfsdk:"repository_id"`
+	RepositoryName     *OrganizationRulesetRepositoryNameConditionModel     `tfsdk:"repository_name"`
+	RepositoryProperty *OrganizationRulesetRepositoryPropertyConditionModel `tfsdk:"repository_property"`
+}
+
+// OrganizationRulesetRepositoryIDConditionModel describes the repository ID condition data model.
+type OrganizationRulesetRepositoryIDConditionModel struct {
+	RepositoryIDs []int64 `tfsdk:"repository_ids"`
+}
+
+// OrganizationRulesetRepositoryNameConditionModel describes the repository name condition data model.
+type OrganizationRulesetRepositoryNameConditionModel struct {
+	Exclude   []string   `tfsdk:"exclude"`
+	Include   []string   `tfsdk:"include"`
+	Protected types.Bool `tfsdk:"protected"`
+}
+
+// OrganizationRulesetRepositoryPropertyConditionModel describes the repository property condition data model.
+type OrganizationRulesetRepositoryPropertyConditionModel struct {
+	Exclude []OrganizationRulesetPropertyTargetModel `tfsdk:"exclude"`
+	Include []OrganizationRulesetPropertyTargetModel `tfsdk:"include"`
+}
+
+// OrganizationRulesetPropertyTargetModel describes the repository property target data model.
+type OrganizationRulesetPropertyTargetModel struct {
+	Name           types.String `tfsdk:"name"`
+	PropertyValues []string     `tfsdk:"property_values"`
+	Source         types.String `tfsdk:"source"`
+}
+
+// Metadata returns the resource metadata.
+func (r *OrganizationRulesetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_organization_ruleset", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *OrganizationRulesetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	propertyTarget := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository property.",
+				Required:            true,
+			},
+			"property_values": schema.ListAttribute{
+				MarkdownDescription: "Values to match the property against.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Source of the property. This can be one of `custom` or `system`; defaults to `custom`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("custom"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("custom", "system"),
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ organization ruleset resource (`github_organization_ruleset`) allows you to manage rulesets for a _GitHub_ organization.",
+		Attributes: map[string]schema.Attribute{
+			"bypass_actors": rulesetBypassActorsAttribute(),
+			"conditions": schema.SingleNestedAttribute{
+				MarkdownDescription: "Conditions that determine which repositories and refs the ruleset applies to; exactly one of `repository_id`, `repository_name` or `repository_property` must be set.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"ref_name": rulesetRefNameConditionAttribute(),
+					"repository_id": schema.SingleNestedAttribute{
+						MarkdownDescription: "Target repositories by ID.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"repository_ids": schema.SetAttribute{
+								MarkdownDescription: "IDs of the repositories to target.",
+								ElementType:         types.Int64Type,
+								Required:            true,
+							},
+						},
+					},
+					"repository_name": schema.SingleNestedAttribute{
+						MarkdownDescription: "Target repositories by name.",
+						Optional:            true,
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("repository_id"),
+								path.MatchRelative().AtParent().AtName("repository_property"),
+							),
+						},
+						Attributes: map[string]schema.Attribute{
+							"exclude": schema.ListAttribute{
+								MarkdownDescription: "Repository names or patterns to exclude.",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Computed:            true,
+								Default:             listdefault.StaticValue(emptyStringList()),
+							},
+							"include": schema.ListAttribute{
+								MarkdownDescription: "Repository names or patterns to include; `~ALL` is supported.",
+								ElementType:         types.StringType,
+								Required:            true,
+							},
+							"protected": schema.BoolAttribute{
+								MarkdownDescription: "If renaming of target repositories is prevented.",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+						},
+					},
+					"repository_property": schema.SingleNestedAttribute{
+						MarkdownDescription: "Target repositories by custom or system property values.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"exclude": schema.ListNestedAttribute{
+								MarkdownDescription: "Property values to exclude.",
+								Optional:            true,
+								Computed:            true,
+								Default:             listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: organizationRulesetPropertyTargetAttrTypes()}, []attr.Value{})),
+								NestedObject:        propertyTarget,
+							},
+							"include": schema.ListNestedAttribute{
+								MarkdownDescription: "Property values to include.",
+								Required:            true,
+								NestedObject:        propertyTarget,
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+						},
+					},
+				},
+			},
+			"enforcement": schema.StringAttribute{
+				MarkdownDescription: "Enforcement level of the ruleset. This can be one of `active`, `evaluate` or `disabled`; `evaluate` is only available with _GitHub Enterprise_.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(github.RulesetEnforcementActive), string(github.RulesetEnforcementEvaluate), string(github.RulesetEnforcementDisabled)),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique identifier of the ruleset.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the ruleset.",
+				Required:            true,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": rulesetRulesAttribute(),
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Target of the ruleset. This can be one of `branch`, `tag` or `push`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(github.RulesetTargetBranch), string(github.RulesetTargetTag), string(github.RulesetTargetPush)),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *OrganizationRulesetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
+}
+
+// Create creates the resource.
+func (r *OrganizationRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationRulesetModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	rs, _, err := client.Organizations.CreateRepositoryRuleset(ctx, organization, fromOrganizationRulesetModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization ruleset.", err.Error())
+		return
+	}
+
+	state := toOrganizationRulesetModel(organization, rs, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read reads the resource state.
+func (r *OrganizationRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationRulesetModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	rs, _, err := client.Organizations.GetRepositoryRuleset(ctx, organization, state.ID.ValueInt64())
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get organization ruleset.", err.Error())
+		return
+	}
+
+	m := toOrganizationRulesetModel(organization, rs, state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Update updates the resource.
+func (r *OrganizationRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationRulesetModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+	id := plan.ID.ValueInt64()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	rs, _, err := client.Organizations.UpdateRepositoryRuleset(ctx, organization, id, fromOrganizationRulesetModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update organization ruleset.", err.Error())
+		return
+	}
+
+	// An empty bypass actor list is omitted from the update request so it needs to be cleared explicitly.
+	if len(plan.BypassActors) == 0 && len(rs.BypassActors) != 0 {
+		_, err = client.Organizations.UpdateRepositoryRulesetClearBypassActor(ctx, organization, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to clear organization ruleset bypass actors.", err.Error())
+			return
+		}
+		rs.BypassActors = nil
+	}
+
+	m := toOrganizationRulesetModel(organization, rs, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Delete deletes the resource.
+func (r *OrganizationRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationRulesetModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	_, err = client.Organizations.DeleteRepositoryRuleset(ctx, organization, state.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete organization ruleset.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *OrganizationRulesetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization", "id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	organization := parts[0]
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", "id must be an integer")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// organizationRulesetPropertyTargetAttrTypes returns the attribute types of a repository property target.
+func organizationRulesetPropertyTargetAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":            types.StringType,
+		"property_values": types.ListType{ElemType: types.StringType},
+		"source":          types.StringType,
+	}
+}
+
+// toOrganizationRulesetModel converts a ruleset to the resource model, the ref name patterns keep the order of the known model
+// when GitHub returns the same patterns in a different order.
+func toOrganizationRulesetModel(org string, rs *github.RepositoryRuleset, known OrganizationRulesetModel) OrganizationRulesetModel {
+	m := OrganizationRulesetModel{
+		BypassActors: toRulesetBypassActorModels(rs.BypassActors),
+		Conditions:   &OrganizationRulesetConditionsModel{},
+		Enforcement:  types.StringValue(string(rs.Enforcement)),
+		ID:           types.Int64Value(rs.GetID()),
+		Name:         types.StringValue(rs.Name),
+		Organization: types.StringValue(org),
+		Rules:        github.Ptr(toRulesetRulesModel(rs.Rules)),
+		Target:       types.StringNull(),
+	}
+
+	if rs.Target != nil {
+		m.Target = types.StringValue(string(*rs.Target))
+	}
+
+	if c := rs.Conditions; c != nil {
+		var knownRefName *RulesetRefNameConditionModel
+		if known.Conditions != nil {
+			knownRefName = known.Conditions.RefName
+		}
+		m.Conditions.RefName = keepRulesetRefNameConditionOrder(toRulesetRefNameConditionModel(c.RefName), knownRefName)
+
+		if c.RepositoryID != nil {
+			m.Conditions.RepositoryID = &OrganizationRulesetRepositoryIDConditionModel{
+				RepositoryIDs: emptyIfNil(c.RepositoryID.RepositoryIDs),
+			}
+		}
+
+		if c.RepositoryName != nil {
+			m.Conditions.RepositoryName = &OrganizationRulesetRepositoryNameConditionModel{
+				Exclude:   emptyIfNil(c.RepositoryName.Exclude),
+				Include:   emptyIfNil(c.RepositoryName.Include),
+				Protected: types.BoolValue(c.RepositoryName.GetProtected()),
+			}
+		}
+
+		if c.RepositoryProperty != nil {
+			m.Conditions.RepositoryProperty = &OrganizationRulesetRepositoryPropertyConditionModel{
+				Exclude: toOrganizationRulesetPropertyTargetModels(c.RepositoryProperty.Exclude),
+				Include: toOrganizationRulesetPropertyTargetModels(c.RepositoryProperty.Include),
+			}
+		}
+	}
+
+	return m
+}
+
+func fromOrganizationRulesetModel(m OrganizationRulesetModel) github.RepositoryRuleset {
+	rs := github.RepositoryRuleset{
+		BypassActors: fromRulesetBypassActorModels(m.BypassActors),
+		Enforcement:  github.RulesetEnforcement(m.Enforcement.ValueString()),
+		Name:         m.Name.ValueString(),
+		Target:       github.Ptr(github.RulesetTarget(m.Target.ValueString())),
+		Rules:        &github.RepositoryRulesetRules{},
+	}
+
+	if m.Rules != nil {
+		rs.Rules = fromRulesetRulesModel(*m.Rules)
+	}
+
+	if c := m.Conditions; c != nil {
+		rs.Conditions = &github.RepositoryRulesetConditions{
+			RefName: fromRulesetRefNameConditionModel(c.RefName),
+		}
+
+		if c.RepositoryID != nil {
+			rs.Conditions.RepositoryID = &github.RepositoryRulesetRepositoryIDsConditionParameters{
+				RepositoryIDs: c.RepositoryID.RepositoryIDs,
+			}
+		}
+
+		if c.RepositoryName != nil {
+			rs.Conditions.RepositoryName = &github.RepositoryRulesetRepositoryNamesConditionParameters{
+				Exclude:   emptyIfNil(c.RepositoryName.Exclude),
+				Include:   emptyIfNil(c.RepositoryName.Include),
+				Protected: c.RepositoryName.Protected.ValueBoolPointer(),
+			}
+		}
+
+		if c.RepositoryProperty != nil {
+			rs.Conditions.RepositoryProperty = &github.RepositoryRulesetRepositoryPropertyConditionParameters{
+				Exclude: fromOrganizationRulesetPropertyTargetModels(c.RepositoryProperty.Exclude),
+				Include: fromOrganizationRulesetPropertyTargetModels(c.RepositoryProperty.Include),
+			}
+		}
+	}
+
+	return rs
+}
+
+func toOrganizationRulesetPropertyTargetModels(targets []*github.RepositoryRulesetRepositoryPropertyTargetParameters) []OrganizationRulesetPropertyTargetModel {
+	m := make([]OrganizationRulesetPropertyTargetModel, 0, len(targets))
+	for _, t := range targets {
+		source := types.StringValue("custom")
+		if t.Source != nil {
+			source = types.StringValue(*t.Source)
+		}
+
+		m = append(m, OrganizationRulesetPropertyTargetModel{
+			Name:           types.StringValue(t.Name),
+			PropertyValues: emptyIfNil(t.PropertyValues),
+			Source:         source,
+		})
+	}
+
+	return m
+}
+
+func fromOrganizationRulesetPropertyTargetModels(m []OrganizationRulesetPropertyTargetModel) []*github.RepositoryRulesetRepositoryPropertyTargetParameters {
+	targets := make([]*github.RepositoryRulesetRepositoryPropertyTargetParameters, 0, len(m))
+	for _, t := range m {
+		targets = append(targets, &github.RepositoryRulesetRepositoryPropertyTargetParameters{
+			Name:           t.Name.ValueString(),
+			PropertyValues: emptyIfNil(t.PropertyValues),
+			Source:         t.Source.ValueStringPointer(),
+		})
+	}
+
+	return targets
+}
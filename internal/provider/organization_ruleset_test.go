@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganizationRulesetResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("create_repository_name", func(t *testing.T) {
+		name := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_organization_ruleset" "test" {
+  organization = "%s"
+  name         = "%s"
+  target       = "branch"
+  enforcement  = "active"
+
+  conditions = {
+    ref_name = {
+      include = ["~DEFAULT_BRANCH"]
+    }
+
+    repository_name = {
+      include = ["%[2]s*"]
+    }
+  }
+
+  rules = {
+    deletion         = true
+    non_fast_forward = true
+  }
+}
+`, accTestConfigData.Values.Organization, name),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("bypass_actors"), knownvalue.SetSizeExact(0)),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("conditions").AtMapKey("ref_name").AtMapKey("include"), knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("~DEFAULT_BRANCH")})),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("conditions").AtMapKey("repository_name").AtMapKey("protected"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("enforcement"), knownvalue.StringExact("active")),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("name"), knownvalue.StringExact(name)),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("rules").AtMapKey("creation"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("rules").AtMapKey("deletion"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("rules").AtMapKey("non_fast_forward"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("target"), knownvalue.StringExact("branch")),
+					},
+				},
+				{
+					ResourceName:      "github_organization_ruleset.test",
+					ImportState:       true,
+					ImportStateIdFunc: testAccOrganizationRulesetImportStateIDFunc("github_organization_ruleset.test"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("create_repository_property", func(t *testing.T) {
+		name := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_organization_property" "test" {
+  organization   = "%[1]s"
+  name           = "%[2]s"
+  value_type     = "single_select"
+  allowed_values = ["option1", "option2"]
+}
+
+resource "github_team" "test" {
+  organization = "%[1]s"
+  name         = "%[2]s"
+}
+
+resource "github_organization_ruleset" "test" {
+  organization = "%[1]s"
+  name         = "%[2]s"
+  target       = "branch"
+  enforcement  = "disabled"
+
+  bypass_actors = [
+    {
+      actor_id    = github_team.test.id
+      actor_type  = "Team"
+      bypass_mode = "pull_request"
+    }
+  ]
+
+  conditions = {
+    ref_name = {
+      include = ["~ALL"]
+      exclude = ["refs/heads/release/*"]
+    }
+
+    repository_property = {
+      include = [
+        {
+          name            = github_organization_property.test.name
+          property_values = ["option1"]
+        }
+      ]
+    }
+  }
+
+  rules = {
+    pull_request = {
+      required_approving_review_count = 1
+    }
+
+    required_status_checks = {
+      required_checks = [
+        {
+          context = "ci"
+        }
+      ]
+    }
+  }
+}
+`, accTestConfigData.Values.Organization, name),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("bypass_actors"), knownvalue.SetSizeExact(1)),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("conditions").AtMapKey("repository_property").AtMapKey("include").AtSliceIndex(0).AtMapKey("source"), knownvalue.StringExact("custom")),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("enforcement"), knownvalue.StringExact("disabled")),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("rules").AtMapKey("pull_request").AtMapKey("required_approving_review_count"), knownvalue.Int64Exact(1)),
+						statecheck.ExpectKnownValue("github_organization_ruleset.test", tfjsonpath.New("rules").AtMapKey("required_status_checks").AtMapKey("strict"), knownvalue.Bool(false)),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("invalid_conditions", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_organization_ruleset" "test" {
+  organization = "%s"
+  name         = "invalid"
+  target       = "branch"
+  enforcement  = "active"
+
+  conditions = {
+    repository_name = {
+      include = ["~ALL"]
+    }
+
+    repository_id = {
+      repository_ids = [1]
+    }
+  }
+
+  rules = {}
+}
+`, accTestConfigData.Values.Organization),
+					ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+				},
+			},
+		})
+	})
+}
+
+func testAccOrganizationRulesetImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["organization"], rs.Primary.Attributes["id"]), nil
+	}
+}
+
+func TestOrganizationRulesetResourceReadNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v3/orgs/test-org/rulesets/1", testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`))
+
+	r := &OrganizationRulesetResource{providerData: testMockProviderData(t, mux)}
+	state := testResourceState(t, r, &OrganizationRulesetModel{
+		Enforcement:  types.StringValue("active"),
+		ID:           types.Int64Value(1),
+		Name:         types.StringValue("test"),
+		Organization: types.StringValue("test-org"),
+		Target:       types.StringValue("branch"),
+	})
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected the ruleset to be removed from the state")
+	}
+}
+
+func TestOrganizationRulesetResourceReadRefNameOrder(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v3/orgs/test-org/rulesets/1", testMockJSON(http.StatusOK, `{
+		"id": 1,
+		"name": "test",
+		"target": "branch",
+		"enforcement": "active",
+		"conditions": {
+			"ref_name": {"include": ["refs/heads/a", "refs/heads/b"], "exclude": []},
+			"repository_name": {"include": ["~ALL"], "exclude": []}
+		}
+	}`))
+
+	r := &OrganizationRulesetResource{providerData: testMockProviderData(t, mux)}
+	state := testResourceState(t, r, &OrganizationRulesetModel{
+		Conditions: &OrganizationRulesetConditionsModel{
+			RefName: &RulesetRefNameConditionModel{
+				Exclude: []string{},
+				Include: []string{"refs/heads/b", "refs/heads/a"},
+			},
+		},
+		Enforcement:  types.StringValue("active"),
+		ID:           types.Int64Value(1),
+		Name:         types.StringValue("test"),
+		Organization: types.StringValue("test-org"),
+		Target:       types.StringValue("branch"),
+	})
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var m OrganizationRulesetModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &m)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if m.Conditions == nil || m.Conditions.RefName == nil {
+		t.Fatal("expected the ref name condition to be set")
+	}
+
+	if got, want := m.Conditions.RefName.Include, []string{"refs/heads/b", "refs/heads/a"}; !slices.Equal(got, want) {
+		t.Fatalf("got include %v, want %v", got, want)
+	}
+}
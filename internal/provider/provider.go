@@ -2,18 +2,27 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terr4m/terraform-provider-github/internal/ghutil"
@@ -43,6 +52,8 @@ type GitHubProviderData struct {
 	Model           *GitHubProviderModel
 	ClientCreator   ghutil.ClientCreator
 	DefaultTimeouts *Timeouts
+
+	teamSyncPlans teamSyncPlans
 }
 
 // Timeouts represents a set of timeouts.
@@ -56,16 +67,52 @@ type Timeouts struct {
 // GitHubProviderModel describes the provider data model.
 type GitHubProviderModel struct {
 	AppAuth       *AppAuthModel  `tfsdk:"app_auth"`
+	BaseURL       types.String   `tfsdk:"base_url"`
+	Cache         *CacheModel    `tfsdk:"cache"`
 	CacheRequests types.Bool     `tfsdk:"cache_requests"`
+	OIDCAuth      *OIDCAuthModel `tfsdk:"oidc_auth"`
+	Retry         *RetryModel    `tfsdk:"retry"`
 	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 	Token         types.String   `tfsdk:"token"`
+	TokenCommand  []types.String `tfsdk:"token_command"`
+	UploadURL     types.String   `tfsdk:"upload_url"`
 }
 
 // AppAuth describes the application authentication configuration.
 type AppAuthModel struct {
-	ID             types.Int64  `tfsdk:"id"`
-	PrivateKey     types.String `tfsdk:"private_key"`
-	PrivateKeyFile types.String `tfsdk:"private_key_file"`
+	DefaultOwner         types.String            `tfsdk:"default_owner"`
+	ID                   types.Int64             `tfsdk:"id"`
+	InstallationID       types.Int64             `tfsdk:"installation_id"`
+	Permissions          map[string]types.String `tfsdk:"permissions"`
+	PrivateKey           types.String            `tfsdk:"private_key"`
+	PrivateKeyFile       types.String            `tfsdk:"private_key_file"`
+	PrivateKeyPassphrase types.String            `tfsdk:"private_key_passphrase"`
+	Repositories         []types.String          `tfsdk:"repositories"`
+	SigningCommand       []types.String          `tfsdk:"signing_command"`
+}
+
+// OIDCAuthModel describes the OIDC token exchange authentication configuration.
+type OIDCAuthModel struct {
+	Audience       types.String   `tfsdk:"audience"`
+	ExchangeURL    types.String   `tfsdk:"exchange_url"`
+	IDTokenCommand []types.String `tfsdk:"id_token_command"`
+}
+
+// CacheModel describes the request cache configuration.
+type CacheModel struct {
+	CleanupPolicy types.String `tfsdk:"cleanup_policy"`
+	Directory     types.String `tfsdk:"directory"`
+	MaxSizeMB     types.Int64  `tfsdk:"max_size_mb"`
+	TTL           types.String `tfsdk:"ttl"`
+}
+
+// RetryModel describes the request retry configuration.
+type RetryModel struct {
+	BaseBackoff           types.String  `tfsdk:"base_backoff"`
+	MaxAttempts           types.Int64   `tfsdk:"max_attempts"`
+	MaxBackoff            types.String  `tfsdk:"max_backoff"`
+	NonIdempotentRequests types.Bool    `tfsdk:"non_idempotent_requests"`
+	RetryableStatusCodes  []types.Int64 `tfsdk:"retryable_status_codes"`
 }
 
 // GitHubProvider defines the provider implementation.
@@ -86,26 +133,152 @@ func (p *GitHubProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		MarkdownDescription: "The GitHub provider provides a way to manage _GitHub_ resources available via the REST API using _Terraform_.",
 		Attributes: map[string]schema.Attribute{
 			"app_auth": schema.SingleNestedAttribute{
-				MarkdownDescription: "GitHub application authentication configuration; this is mutually exclusive with `token`. If `private_key` or `private_key_file` are not provided, the provider will attempt to use the `GITHUB_APP_PRIVATE_KEY` and then `GITHUB_APP_PRIVATE_KEY_FILE` environment variables.",
+				MarkdownDescription: "GitHub application authentication configuration; this is mutually exclusive with `oidc_auth`, `token` and `token_command`. If `private_key`, `private_key_file` or `signing_command` are not provided, the provider will attempt to use the `GITHUB_APP_PRIVATE_KEY` and then `GITHUB_APP_PRIVATE_KEY_FILE` environment variables.",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
+					"default_owner": schema.StringAttribute{
+						MarkdownDescription: "The login of the user or organization whose installation should be used for requests that aren't scoped to an organization; this is mutually exclusive with `installation_id`. If neither are set the application must only have a single installation.",
+						Optional:            true,
+					},
 					"id": schema.Int64Attribute{
 						MarkdownDescription: "The GitHub application ID.",
 						Required:            true,
 					},
+					"installation_id": schema.Int64Attribute{
+						MarkdownDescription: "The ID of the installation to use for requests that aren't scoped to an organization; this is mutually exclusive with `default_owner`. Requests scoped to an organization always use the organization installation.",
+						Optional:            true,
+					},
+					"permissions": schema.MapAttribute{
+						MarkdownDescription: "The permissions to request for installation tokens, as a map of permission names such as `contents` or `members` to the access level (`read`, `write` or `admin`). If this isn't set tokens have all of the installation permissions; tokens can never have permissions that the installation doesn't have.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+							mapvalidator.ValueStringsAre(stringvalidator.OneOf("read", "write", "admin")),
+						},
+					},
 					"private_key": schema.StringAttribute{
-						MarkdownDescription: "The private key for the GitHub application; this is mutually exclusive with `private_key_file`.",
+						MarkdownDescription: "The PEM encoded RSA private key for the GitHub application, which can also be base64 encoded; this is mutually exclusive with `private_key_file` and `signing_command`.",
 						Optional:            true,
+						Sensitive:           true,
 					},
 					"private_key_file": schema.StringAttribute{
-						MarkdownDescription: "The file containing the private key for the GitHub application; this is mutually exclusive with `private_key`.",
+						MarkdownDescription: "The file containing the private key for the GitHub application, in the same formats as `private_key`; this is mutually exclusive with `private_key` and `signing_command`.",
+						Optional:            true,
+					},
+					"private_key_passphrase": schema.StringAttribute{
+						MarkdownDescription: "The passphrase for an encrypted PKCS #8 private key (`ENCRYPTED PRIVATE KEY`), which must use PBES2 with PBKDF2 or scrypt as created by current versions of _OpenSSL_. If this isn't set the provider will look for the `GITHUB_APP_PRIVATE_KEY_PASSPHRASE` environment variable.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"repositories": schema.SetAttribute{
+						MarkdownDescription: "The names of the repositories that installation tokens can access. If this isn't set tokens can access all of the installation repositories; as repositories belong to a single owner this is only useful when the provider manages a single organization.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+					"signing_command": schema.ListAttribute{
+						MarkdownDescription: "A command, as the executable followed by its arguments, which signs the application JWTs so that the private key can be kept in an external system such as a KMS; this is mutually exclusive with `private_key` and `private_key_file`. The base64 encoded SHA-256 digest to sign is written to the command's standard input and the command must write the base64 encoded RSASSA-PKCS1-v1_5 signature to its standard output.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "The _GitHub Enterprise Server_ URL to use instead of the public _GitHub_ API, such as `https://github.example.com/`; the `/api/v3/` path is added if it isn't present. If this isn't set the provider will look for the `GITHUB_BASE_URL` environment variable.",
+				Optional:            true,
+			},
+			"cache": schema.SingleNestedAttribute{
+				MarkdownDescription: "Request cache configuration; this is only used if `cache_requests` is `true`. Responses are stored on disk for each set of credentials so they can be reused between _Terraform_ runs. Parallel runs can safely share the same directory, but the cache for a set of credentials can only be used by one run at a time so the other runs don't cache those requests.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"cleanup_policy": schema.StringAttribute{
+						MarkdownDescription: "How entries are removed from the cache when it is opened; `expired` removes expired entries and then the oldest entries until the cache is under `max_size_mb`, `all` removes all entries, and `none` doesn't remove any entries. Unless the policy is `none`, cache files for other credentials which haven't been used within the `ttl` (or 30 days if responses never expire) are also removed. Defaults to `expired`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("expired", "all", "none"),
+						},
+					},
+					"directory": schema.StringAttribute{
+						MarkdownDescription: "The directory to store the cache in; defaults to `terraform-provider-github` in the user cache directory.",
+						Optional:            true,
+					},
+					"max_size_mb": schema.Int64Attribute{
+						MarkdownDescription: "The maximum size of the cache for each set of credentials in megabytes, `0` means the size is unlimited; the cache file is compacted when it's opened if it's larger than this. Defaults to `100`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"ttl": schema.StringAttribute{
+						MarkdownDescription: "How long a cached response can be used for, `0s` means responses never expire; defaults to `24h`. This should be a string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) consisting of numbers and unit suffixes, such as `30m` or `2h45m`. Valid time units are `s` (seconds), `m` (minutes), `h` (hours).",
 						Optional:            true,
 					},
 				},
 			},
 			"cache_requests": schema.BoolAttribute{
-				MarkdownDescription: "If `true`, the provider will cache requests to the GitHub API. This can help reduce the number of requests made to the API, but may result in stale data being returned. Defaults to `false`.",
+				MarkdownDescription: "If `true`, the provider will cache requests to the GitHub API using conditional requests. This can help reduce the number of requests made to the API, but may result in stale data being returned. Defaults to `false`.",
+				Optional:            true,
+			},
+			"oidc_auth": schema.SingleNestedAttribute{
+				MarkdownDescription: "OIDC token exchange authentication configuration, which allows _GitHub Actions_ workflows and other workloads with an OIDC identity to use the provider without a long-lived secret; this is mutually exclusive with `app_auth`, `token` and `token_command`. The ID token is sent to a token exchange service, such as [Octo STS](https://github.com/octo-sts/app), which returns a _GitHub_ token; a new token is exchanged before the current one expires.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"audience": schema.StringAttribute{
+						MarkdownDescription: "The audience to request for the _GitHub Actions_ ID token; defaults to the host of `exchange_url`. This isn't used if `id_token_command` is set.",
+						Optional:            true,
+					},
+					"exchange_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the token exchange service, including any query parameters it requires. The ID token is sent as a bearer token in a `GET` request and the response must be a JSON object with a `token` and an optional `expires_at` in RFC 3339 format; tokens without an expiry are replaced after an hour.",
+						Required:            true,
+					},
+					"id_token_command": schema.ListAttribute{
+						MarkdownDescription: "A command, as the executable followed by its arguments, which writes an OIDC ID token to standard output. If this isn't set the _GitHub Actions_ ID token is requested using the `ACTIONS_ID_TOKEN_REQUEST_URL` and `ACTIONS_ID_TOKEN_REQUEST_TOKEN` environment variables, which requires the workflow to have the `id-token: write` permission.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"retry": schema.SingleNestedAttribute{
+				MarkdownDescription: "Request retry configuration for transient failures; `GET`, `HEAD`, `OPTIONS`, `PUT` and `DELETE` requests that fail with a retryable status code, a secondary rate limit `403` without rate limit headers, or a connection reset are retried with a jittered exponential backoff.",
 				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"base_backoff": schema.StringAttribute{
+						MarkdownDescription: "The backoff before the first retry, which doubles for each subsequent retry; defaults to `1s`. This should be a string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) consisting of numbers and unit suffixes, such as `500ms` or `2s`.",
+						Optional:            true,
+					},
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of times a request is attempted including the first attempt, `1` disables retries. Defaults to `3`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: "The maximum backoff between retries; defaults to `30s`. This should be a string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) consisting of numbers and unit suffixes, such as `30s` or `1m`.",
+						Optional:            true,
+					},
+					"non_idempotent_requests": schema.BoolAttribute{
+						MarkdownDescription: "If `true`, `POST` and `PATCH` requests, including _GraphQL_ requests, are also retried; this can create duplicate resources or send duplicate invitations if a request was processed before it failed. Defaults to `false`.",
+						Optional:            true,
+					},
+					"retryable_status_codes": schema.ListAttribute{
+						MarkdownDescription: "The response status codes that are retried; defaults to `[500, 502, 503, 504]`.",
+						Optional:            true,
+						ElementType:         types.Int64Type,
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+						},
+					},
+				},
 			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create:            true,
@@ -118,7 +291,19 @@ func (p *GitHubProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				DeleteDescription: "Timeout for resource deletion; defaults to `10m`. This should be a string that can be [parsed as a duration] (https://pkg.go.dev/time#ParseDuration) consisting of numbers and unit suffixes, such as `30s` or `2h45m`. Valid time units are `s` (seconds), `m` (minutes), `h` (hours).",
 			}),
 			"token": schema.StringAttribute{
-				MarkdownDescription: "A GitHub token to use for authentication; this is mutually exclusive with `app_auth`. If `app_auth` isn;t configured and this isn't set the provider will look for the `GITHUB_TOKEN` environment variable.",
+				MarkdownDescription: "A GitHub token to use for authentication; this is mutually exclusive with `app_auth`, `oidc_auth` and `token_command`. If none of these are configured and this isn't set the provider will look for the `GITHUB_TOKEN` environment variable.",
+				Optional:            true,
+			},
+			"token_command": schema.ListAttribute{
+				MarkdownDescription: "A command, as the executable followed by its arguments, which writes a GitHub token to standard output, such as `[\"gh\", \"auth\", \"token\"]`; this is mutually exclusive with `app_auth`, `oidc_auth` and `token`. The output is either the token on its own or a JSON object with a `token` and an optional `expires_at` in RFC 3339 format; the command is run again before the token expires.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"upload_url": schema.StringAttribute{
+				MarkdownDescription: "The _GitHub Enterprise Server_ upload URL; the `/api/uploads/` path is added if it isn't present. This is only used if a base URL is configured and defaults to the base URL.",
 				Optional:            true,
 			},
 		},
@@ -130,11 +315,18 @@ func (p *GitHubProvider) ConfigValidators(ctx context.Context) []provider.Config
 	return []provider.ConfigValidator{
 		providervalidator.Conflicting(
 			path.MatchRoot("app_auth"),
+			path.MatchRoot("oidc_auth"),
 			path.MatchRoot("token"),
+			path.MatchRoot("token_command"),
 		),
 		providervalidator.Conflicting(
 			path.MatchRoot("app_auth").AtName("private_key"),
 			path.MatchRoot("app_auth").AtName("private_key_file"),
+			path.MatchRoot("app_auth").AtName("signing_command"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("app_auth").AtName("installation_id"),
+			path.MatchRoot("app_auth").AtName("default_owner"),
 		),
 	}
 }
@@ -152,41 +344,116 @@ func (p *GitHubProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	cacheOpts, diags := cacheOptions(model)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	retryOpts, diags := retryOptions(model)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientOpts := ghutil.ClientOptions{
+		BaseURL:   model.BaseURL.ValueString(),
+		UploadURL: model.UploadURL.ValueString(),
+		Cache:     cacheOpts,
+		Retry:     retryOpts,
+	}
+
+	if model.BaseURL.IsNull() {
+		clientOpts.BaseURL = os.Getenv("GITHUB_BASE_URL")
+	}
+
+	if len(clientOpts.BaseURL) != 0 {
+		if u, err := url.Parse(clientOpts.BaseURL); err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("base_url"), "Invalid base URL.", fmt.Sprintf("%q isn't an absolute URL.", clientOpts.BaseURL))
+			return
+		}
+	}
+
 	var clientCreator ghutil.ClientCreator
-	cacheRequests := model.CacheRequests.ValueBool()
 	if model.AppAuth != nil {
-		var privateKey []byte
 		appID := model.AppAuth.ID.ValueInt64()
 
-		if !model.AppAuth.PrivateKey.IsNull() {
-			privateKey = []byte(model.AppAuth.PrivateKey.ValueString())
+		key := ghutil.AppKey{Passphrase: model.AppAuth.PrivateKeyPassphrase.ValueString()}
+		if model.AppAuth.PrivateKeyPassphrase.IsNull() {
+			key.Passphrase = os.Getenv("GITHUB_APP_PRIVATE_KEY_PASSPHRASE")
+		}
+
+		if model.AppAuth.SigningCommand != nil {
+			args := make([]string, 0, len(model.AppAuth.SigningCommand))
+			for _, arg := range model.AppAuth.SigningCommand {
+				args = append(args, arg.ValueString())
+			}
+
+			signer, err := ghutil.NewCommandSigner(args)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("app_auth").AtName("signing_command"), "Invalid signing command.", err.Error())
+				return
+			}
+			key.Signer = signer
+		} else if !model.AppAuth.PrivateKey.IsNull() {
+			key.PEM = []byte(model.AppAuth.PrivateKey.ValueString())
 		} else if !model.AppAuth.PrivateKeyFile.IsNull() {
-			k, err := os.ReadFile(model.AppAuth.PrivateKeyFile.String())
+			k, err := os.ReadFile(model.AppAuth.PrivateKeyFile.ValueString())
 			if err != nil {
 				resp.Diagnostics.AddError("Failed to read private key file", err.Error())
 				return
 			}
-			privateKey = k
+			key.PEM = k
 		} else if v := os.Getenv("GITHUB_APP_PRIVATE_KEY"); len(v) != 0 {
-			privateKey = []byte(v)
+			key.PEM = []byte(v)
 		} else if v := os.Getenv("GITHUB_APP_PRIVATE_KEY_FILE"); len(v) != 0 {
 			k, err := os.ReadFile(v)
 			if err != nil {
 				resp.Diagnostics.AddError("Failed to read private key file", err.Error())
 				return
 			}
-			privateKey = k
+			key.PEM = k
 		} else {
-			resp.Diagnostics.AddError("Private key not provided", "no private key was provided for the app auth")
+			resp.Diagnostics.AddError("Private key not provided", "no private key or signing command was provided for the app auth")
+			return
+		}
+
+		scope, diags := tokenScope(model.AppAuth.Permissions, model.AppAuth.Repositories, path.Root("app_auth").AtName("permissions"))
+		if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 			return
 		}
 
-		cc, err := ghutil.NewAppClientCreator(appID, privateKey, 10, cacheRequests)
+		cc, err := ghutil.NewAppClientCreator(appID, key, model.AppAuth.InstallationID.ValueInt64(), model.AppAuth.DefaultOwner.ValueString(), scope, 10, clientOpts)
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to create GitHub client creator", err.Error())
 			return
 		}
 		clientCreator = cc
+	} else if model.OIDCAuth != nil {
+		oidcOpts := ghutil.OIDCOptions{
+			ExchangeURL: model.OIDCAuth.ExchangeURL.ValueString(),
+			Audience:    model.OIDCAuth.Audience.ValueString(),
+		}
+		for _, arg := range model.OIDCAuth.IDTokenCommand {
+			oidcOpts.IDTokenCommand = append(oidcOpts.IDTokenCommand, arg.ValueString())
+		}
+
+		cc, err := ghutil.NewOIDCClientCreator(oidcOpts, clientOpts)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("oidc_auth").AtName("exchange_url"), "Failed to create GitHub client creator", err.Error())
+			return
+		}
+		clientCreator = cc
+	} else if model.TokenCommand != nil {
+		args := make([]string, 0, len(model.TokenCommand))
+		for _, arg := range model.TokenCommand {
+			args = append(args, arg.ValueString())
+		}
+
+		cc, err := ghutil.NewCommandClientCreator(args, clientOpts)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("token_command"), "Failed to create GitHub client creator", err.Error())
+			return
+		}
+		clientCreator = cc
 	} else {
 		var token *string
 
@@ -196,7 +463,7 @@ func (p *GitHubProvider) Configure(ctx context.Context, req provider.ConfigureRe
 			token = &v
 		}
 
-		cc, err := ghutil.NewClientCreator(token, cacheRequests)
+		cc, err := ghutil.NewClientCreator(token, clientOpts)
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to create GitHub client creator", err.Error())
 			return
@@ -235,26 +502,154 @@ func (p *GitHubProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+}
+
+// cacheOptions returns the cache options for the provider model, or nil if requests shouldn't be cached.
+func cacheOptions(model *GitHubProviderModel) (*ghutil.CacheOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !model.CacheRequests.ValueBool() {
+		return nil, diags
+	}
+
+	opts := &ghutil.CacheOptions{
+		Directory: ghutil.DefaultCacheDirectory(),
+		MaxSize:   100 * 1024 * 1024,
+		TTL:       24 * time.Hour,
+		Cleanup:   ghutil.CacheCleanupExpired,
+	}
+
+	if model.Cache == nil {
+		return opts, diags
+	}
+
+	if !model.Cache.CleanupPolicy.IsNull() {
+		opts.Cleanup = ghutil.CacheCleanupPolicy(model.Cache.CleanupPolicy.ValueString())
+	}
+
+	if !model.Cache.Directory.IsNull() {
+		opts.Directory = model.Cache.Directory.ValueString()
+	}
+
+	if !model.Cache.MaxSizeMB.IsNull() {
+		opts.MaxSize = model.Cache.MaxSizeMB.ValueInt64() * 1024 * 1024
+	}
+
+	if !model.Cache.TTL.IsNull() {
+		ttl, err := time.ParseDuration(model.Cache.TTL.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("cache").AtName("ttl"), "Invalid cache TTL.", err.Error())
+			return nil, diags
+		}
+		opts.TTL = ttl
+	}
+
+	return opts, diags
+}
+
+// retryOptions returns the retry options for the provider model.
+func retryOptions(model *GitHubProviderModel) (*ghutil.RetryOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := ghutil.DefaultRetryOptions()
+
+	if model.Retry == nil {
+		return opts, diags
+	}
+
+	if !model.Retry.MaxAttempts.IsNull() {
+		opts.MaxAttempts = int(model.Retry.MaxAttempts.ValueInt64())
+	}
+
+	if !model.Retry.BaseBackoff.IsNull() {
+		d, err := time.ParseDuration(model.Retry.BaseBackoff.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("retry").AtName("base_backoff"), "Invalid retry base backoff.", err.Error())
+			return nil, diags
+		}
+		opts.BaseBackoff = d
+	}
+
+	if !model.Retry.MaxBackoff.IsNull() {
+		d, err := time.ParseDuration(model.Retry.MaxBackoff.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("retry").AtName("max_backoff"), "Invalid retry max backoff.", err.Error())
+			return nil, diags
+		}
+		opts.MaxBackoff = d
+	}
+
+	opts.NonIdempotent = model.Retry.NonIdempotentRequests.ValueBool()
+
+	if model.Retry.RetryableStatusCodes != nil {
+		opts.RetryableStatusCodes = make([]int, 0, len(model.Retry.RetryableStatusCodes))
+		for _, c := range model.Retry.RetryableStatusCodes {
+			opts.RetryableStatusCodes = append(opts.RetryableStatusCodes, int(c.ValueInt64()))
+		}
+	}
+
+	return opts, diags
+}
+
+// tokenScope returns the installation token scope for the permissions and repositories, errors are reported for the
+// permissions path.
+func tokenScope(permissions map[string]types.String, repositories []types.String, permissionsPath path.Path) (ghutil.TokenScope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scope := ghutil.TokenScope{}
+
+	if len(permissions) != 0 {
+		scope.Permissions = make(map[string]string, len(permissions))
+		for name, level := range permissions {
+			scope.Permissions[name] = level.ValueString()
+		}
+	}
+
+	for _, r := range repositories {
+		scope.Repositories = append(scope.Repositories, r.ValueString())
+	}
+
+	if err := scope.Validate(); err != nil {
+		diags.AddAttributeError(permissionsPath, "Invalid token permissions.", err.Error())
+	}
+
+	return scope, diags
 }
 
 // Resources returns the provider resources.
 func (p *GitHubProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewBranchProtectionResource,
+		NewOrganizationMembershipResource,
 		NewOrganizationPropertyResource,
+		NewOrganizationRulesetResource,
+		NewRepositoryCustomPropertiesResource,
+		NewRepositoryResource,
+		NewRepositoryRulesetResource,
+		NewTeamExternalGroupResource,
+		NewTeamIDPSyncResource,
+		NewTeamMembersResource,
 		NewTeamMembershipResource,
+		NewTeamRepositoriesResource,
+		NewTeamRepositoryResource,
 		NewTeamResource,
 	}
 }
 
 // EphemeralResources returns the provider ephemeral resources.
 func (p *GitHubProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewAppInstallationTokenEphemeralResource,
+	}
 }
 
 // DataSources returns the provider data sources.
 func (p *GitHubProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewExternalGroupsDataSource,
 		NewOrganizationDataSource,
+		NewOrganizationInvitationsDataSource,
 		NewOrganizationPropertiesDataSource,
 		NewTeamDataSource,
 		NewTeamMembersDataSource,
@@ -264,5 +659,11 @@ func (p *GitHubProvider) DataSources(ctx context.Context) []func() datasource.Da
 
 // Functions returns the provider functions.
 func (p *GitHubProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewParseRepositoryFunction,
+		NewParseTeamFunction,
+		NewParseURLFunction,
+		NewRefToBranchFunction,
+		NewTeamSlugFunction,
+	}
 }
@@ -0,0 +1,545 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+// repositoryGenerationTimeout is how long to wait for a repository generated from a template to become available, and
+// repositoryGenerationInterval is how often it's checked.
+var (
+	repositoryGenerationTimeout  = 2 * time.Minute
+	repositoryGenerationInterval = 2 * time.Second
+)
+
+var (
+	_ resource.Resource                = &RepositoryResource{}
+	_ resource.ResourceWithConfigure   = &RepositoryResource{}
+	_ resource.ResourceWithImportState = &RepositoryResource{}
+)
+
+// NewRepositoryResource creates a new RepositoryResource.
+func NewRepositoryResource() resource.Resource {
+	return &RepositoryResource{}
+}
+
+// RepositoryResource defines the resource implementation.
+type RepositoryResource struct {
+	providerData *GitHubProviderData
+}
+
+// RepositoryModel describes the data model.
+type RepositoryModel struct {
+	AllowAutoMerge      types.Bool               `tfsdk:"allow_auto_merge"`
+	AllowMergeCommit    types.Bool               `tfsdk:"allow_merge_commit"`
+	AllowRebaseMerge    types.Bool               `tfsdk:"allow_rebase_merge"`
+	AllowSquashMerge    types.Bool               `tfsdk:"allow_squash_merge"`
+	ArchiveOnDestroy    types.Bool               `tfsdk:"archive_on_destroy"`
+	AutoInit            types.Bool               `tfsdk:"auto_init"`
+	DefaultBranch       types.String             `tfsdk:"default_branch"`
+	DeleteBranchOnMerge types.Bool               `tfsdk:"delete_branch_on_merge"`
+	Description         types.String             `tfsdk:"description"`
+	FullName            types.String             `tfsdk:"full_name"`
+	Homepage            types.String             `tfsdk:"homepage"`
+	HTMLURL             types.String             `tfsdk:"html_url"`
+	ID                  types.Int64              `tfsdk:"id"`
+	Name                types.String             `tfsdk:"name"`
+	Organization        types.String             `tfsdk:"organization"`
+	Template            *RepositoryTemplateModel `tfsdk:"template"`
+	Topics              types.Set                `tfsdk:"topics"`
+	Visibility          types.String             `tfsdk:"visibility"`
+}
+
+// RepositoryTemplateModel describes the template data model.
+type RepositoryTemplateModel struct {
+	IncludeAllBranches types.Bool   `tfsdk:"include_all_branches"`
+	Owner              types.String `tfsdk:"owner"`
+	Repository         types.String `tfsdk:"repository"`
+}
+
+// Metadata returns the resource metadata.
+func (r *RepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_repository", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ repository resource (`github_repository`) allows you to manage repositories for a _GitHub_ organization.",
+		Attributes: map[string]schema.Attribute{
+			"allow_auto_merge": schema.BoolAttribute{
+				MarkdownDescription: "If pull requests can be set to merge automatically.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_merge_commit": schema.BoolAttribute{
+				MarkdownDescription: "If pull requests can be merged with a merge commit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"allow_rebase_merge": schema.BoolAttribute{
+				MarkdownDescription: "If pull requests can be rebase merged.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"allow_squash_merge": schema.BoolAttribute{
+				MarkdownDescription: "If pull requests can be squash merged.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"archive_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the repository will be archived instead of deleted when the resource is destroyed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"auto_init": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the repository will be created with an initial commit on the default branch; this is only used when the repository is created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default_branch": schema.StringAttribute{
+				MarkdownDescription: "Default branch of the repository.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_branch_on_merge": schema.BoolAttribute{
+				MarkdownDescription: "If head branches are automatically deleted when pull requests are merged.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the repository.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "Full name of the repository in the format `organization/name`.",
+				Computed:            true,
+			},
+			"homepage": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository homepage.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"html_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository on _GitHub_.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique identifier of the repository.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository; changing this renames the repository.",
+				Required:            true,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization the repository belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template": schema.SingleNestedAttribute{
+				MarkdownDescription: "Template repository to create the repository from; changing this forces a new repository to be created.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"include_all_branches": schema.BoolAttribute{
+						MarkdownDescription: "If all branches from the template repository should be included, instead of only the default branch.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"owner": schema.StringAttribute{
+						MarkdownDescription: "Owner of the template repository.",
+						Required:            true,
+					},
+					"repository": schema.StringAttribute{
+						MarkdownDescription: "Name of the template repository.",
+						Required:            true,
+					},
+				},
+			},
+			"topics": schema.SetAttribute{
+				MarkdownDescription: "Topics of the repository.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, nil)),
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Visibility of the repository. This can be one of `public`, `private` or `internal`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("private"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "private", "internal"),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *RepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
+}
+
+// Create creates the resource.
+func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RepositoryModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	repo := fromRepositoryModel(plan)
+
+	var rp *github.Repository
+	if plan.Template != nil {
+		tr := &github.TemplateRepoRequest{
+			Name:               github.Ptr(plan.Name.ValueString()),
+			Owner:              github.Ptr(organization),
+			Description:        github.Ptr(plan.Description.ValueString()),
+			IncludeAllBranches: github.Ptr(plan.Template.IncludeAllBranches.ValueBool()),
+			Private:            github.Ptr(plan.Visibility.ValueString() != "public"),
+		}
+
+		generated, _, err := client.Repositories.CreateFromTemplate(ctx, plan.Template.Owner.ValueString(), plan.Template.Repository.ValueString(), tr)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create repository from template.", err.Error())
+			return
+		}
+
+		// Template generation is asynchronous and only supports a subset of the settings, so the rest are applied with an edit
+		// once the repository is available.
+		rp, err = waitForGeneratedRepository(ctx, func() (*github.Repository, error) {
+			rp, _, err := client.Repositories.Edit(ctx, organization, plan.Name.ValueString(), repo)
+			return rp, err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update repository.", err.Error())
+			resp.Diagnostics.Append(setPartialRepositoryState(ctx, &resp.State, plan, generated)...)
+			return
+		}
+	} else {
+		repo.AutoInit = plan.AutoInit.ValueBoolPointer()
+
+		rp, _, err = client.Repositories.Create(ctx, organization, repo)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create repository.", err.Error())
+			return
+		}
+	}
+
+	topics, diags := setRepositoryTopics(ctx, client, organization, rp.GetName(), plan.Topics)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(setPartialRepositoryState(ctx, &resp.State, plan, rp)...)
+		return
+	}
+	rp.Topics = topics
+
+	state, diags := toRepositoryModel(ctx, organization, rp)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	state.ArchiveOnDestroy = plan.ArchiveOnDestroy
+	state.AutoInit = plan.AutoInit
+	state.Template = plan.Template
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read reads the resource state.
+func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	rp, _, err := client.Repositories.Get(ctx, organization, state.Name.ValueString())
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get repository.", err.Error())
+		return
+	}
+
+	m, diags := toRepositoryModel(ctx, organization, rp)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	m.ArchiveOnDestroy = state.ArchiveOnDestroy
+	if m.ArchiveOnDestroy.IsNull() {
+		m.ArchiveOnDestroy = types.BoolValue(false)
+	}
+	m.AutoInit = state.AutoInit
+	if m.AutoInit.IsNull() {
+		m.AutoInit = types.BoolValue(false)
+	}
+	m.Template = state.Template
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Update updates the resource.
+func (r *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state RepositoryModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	rp, _, err := client.Repositories.Edit(ctx, organization, state.Name.ValueString(), fromRepositoryModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update repository.", err.Error())
+		return
+	}
+
+	topics, diags := setRepositoryTopics(ctx, client, organization, rp.GetName(), plan.Topics)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	rp.Topics = topics
+
+	m, diags := toRepositoryModel(ctx, organization, rp)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	m.ArchiveOnDestroy = plan.ArchiveOnDestroy
+	m.AutoInit = plan.AutoInit
+	m.Template = plan.Template
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Delete deletes the resource.
+func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	if state.ArchiveOnDestroy.ValueBool() {
+		_, _, err = client.Repositories.Edit(ctx, organization, state.Name.ValueString(), &github.Repository{Archived: github.Ptr(true)})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to archive repository.", err.Error())
+			return
+		}
+		return
+	}
+
+	_, err = client.Repositories.Delete(ctx, organization, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete repository.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *RepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization", "name")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	organization := parts[0]
+	name := parts[1]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("archive_on_destroy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("auto_init"), false)...)
+}
+
+// waitForGeneratedRepository calls f until it stops failing with a "404 Not Found" or "409 Conflict" response, which are
+// returned while a repository is being generated from a template, or the generation timeout is reached.
+func waitForGeneratedRepository(ctx context.Context, f func() (*github.Repository, error)) (*github.Repository, error) {
+	ctx, cancel := context.WithTimeout(ctx, repositoryGenerationTimeout)
+	defer cancel()
+
+	for {
+		rp, err := f()
+		if err == nil || (!ghutil.IsNotFound(err) && !ghutil.IsConflict(err)) {
+			return rp, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("repository wasn't available after %s: %w", repositoryGenerationTimeout, err)
+		case <-time.After(repositoryGenerationInterval):
+		}
+	}
+}
+
+// setPartialRepositoryState sets the state of a repository which was created but couldn't be fully configured, so that it's
+// tracked and replaced by the next apply instead of failing because the repository already exists.
+func setPartialRepositoryState(ctx context.Context, state *tfsdk.State, plan RepositoryModel, rp *github.Repository) diag.Diagnostics {
+	m, diags := toRepositoryModel(ctx, plan.Organization.ValueString(), rp)
+	if diags.HasError() {
+		return diags
+	}
+	m.ArchiveOnDestroy = plan.ArchiveOnDestroy
+	m.AutoInit = plan.AutoInit
+	m.Template = plan.Template
+
+	return state.Set(ctx, &m)
+}
+
+// setRepositoryTopics replaces the topics of a repository and returns the resulting topics.
+func setRepositoryTopics(ctx context.Context, client *github.Client, owner, repo string, topics types.Set) ([]string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	names := make([]string, 0, len(topics.Elements()))
+	if !topics.IsNull() && !topics.IsUnknown() {
+		if d := topics.ElementsAs(ctx, &names, false); d.HasError() {
+			return nil, d
+		}
+	}
+
+	t, _, err := client.Repositories.ReplaceAllTopics(ctx, owner, repo, names)
+	if err != nil {
+		diags.AddError("Failed to set repository topics.", err.Error())
+		return nil, diags
+	}
+
+	return t, diags
+}
+
+func toRepositoryModel(ctx context.Context, org string, r *github.Repository) (RepositoryModel, diag.Diagnostics) {
+	if r == nil {
+		diags := diag.Diagnostics{}
+		diags.AddError("Failed to convert to repository model.", "repository is nil")
+		return RepositoryModel{}, diags
+	}
+
+	names := r.Topics
+	if names == nil {
+		names = []string{}
+	}
+
+	topics, diags := types.SetValueFrom(ctx, types.StringType, names)
+	if diags.HasError() {
+		return RepositoryModel{}, diags
+	}
+
+	m := RepositoryModel{
+		AllowAutoMerge:      types.BoolValue(r.GetAllowAutoMerge()),
+		AllowMergeCommit:    types.BoolValue(r.GetAllowMergeCommit()),
+		AllowRebaseMerge:    types.BoolValue(r.GetAllowRebaseMerge()),
+		AllowSquashMerge:    types.BoolValue(r.GetAllowSquashMerge()),
+		DefaultBranch:       types.StringValue(r.GetDefaultBranch()),
+		DeleteBranchOnMerge: types.BoolValue(r.GetDeleteBranchOnMerge()),
+		Description:         types.StringValue(r.GetDescription()),
+		FullName:            types.StringValue(r.GetFullName()),
+		Homepage:            types.StringValue(r.GetHomepage()),
+		HTMLURL:             types.StringValue(r.GetHTMLURL()),
+		ID:                  types.Int64Value(r.GetID()),
+		Name:                types.StringValue(r.GetName()),
+		Organization:        types.StringValue(org),
+		Topics:              topics,
+		Visibility:          types.StringValue(r.GetVisibility()),
+	}
+
+	return m, diag.Diagnostics{}
+}
+
+func fromRepositoryModel(m RepositoryModel) *github.Repository {
+	return &github.Repository{
+		AllowAutoMerge:      m.AllowAutoMerge.ValueBoolPointer(),
+		AllowMergeCommit:    m.AllowMergeCommit.ValueBoolPointer(),
+		AllowRebaseMerge:    m.AllowRebaseMerge.ValueBoolPointer(),
+		AllowSquashMerge:    m.AllowSquashMerge.ValueBoolPointer(),
+		DeleteBranchOnMerge: m.DeleteBranchOnMerge.ValueBoolPointer(),
+		Description:         m.Description.ValueStringPointer(),
+		Homepage:            m.Homepage.ValueStringPointer(),
+		Name:                m.Name.ValueStringPointer(),
+		Visibility:          m.Visibility.ValueStringPointer(),
+	}
+}
@@ -0,0 +1,455 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                = &RepositoryCustomPropertiesResource{}
+	_ resource.ResourceWithConfigure   = &RepositoryCustomPropertiesResource{}
+	_ resource.ResourceWithImportState = &RepositoryCustomPropertiesResource{}
+)
+
+// NewRepositoryCustomPropertiesResource creates a new RepositoryCustomPropertiesResource.
+func NewRepositoryCustomPropertiesResource() resource.Resource {
+	return &RepositoryCustomPropertiesResource{}
+}
+
+// RepositoryCustomPropertiesResource defines the resource implementation.
+type RepositoryCustomPropertiesResource struct {
+	providerData *GitHubProviderData
+}
+
+// RepositoryCustomPropertiesModel describes the data model.
+type RepositoryCustomPropertiesModel struct {
+	Organization types.String                                  `tfsdk:"organization"`
+	Properties   map[string]RepositoryCustomPropertyValueModel `tfsdk:"properties"`
+	Repository   types.String                                  `tfsdk:"repository"`
+}
+
+// RepositoryCustomPropertyValueModel describes the property value data model.
+type RepositoryCustomPropertyValueModel struct {
+	Value  types.String `tfsdk:"value"`
+	Values types.List   `tfsdk:"values"`
+}
+
+// Metadata returns the resource metadata.
+func (r *RepositoryCustomPropertiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_repository_custom_properties", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *RepositoryCustomPropertiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ repository custom properties resource (`github_repository_custom_properties`) allows you to manage the custom property values of a _GitHub_ repository. Only the properties configured are managed; values for other properties are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization the repository belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"properties": schema.MapNestedAttribute{
+				MarkdownDescription: "Map of property names to their values; the properties must be defined for the organization.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of a `string`, `single_select` or `true_false` property; this is mutually exclusive with `values`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("values")),
+							},
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "Values of a `multi_select` property; this is mutually exclusive with `value`.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+							},
+						},
+					},
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *RepositoryCustomPropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
+}
+
+// Create creates the resource.
+func (r *RepositoryCustomPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RepositoryCustomPropertiesModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+	repository := plan.Repository.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	values, diags := fromRepositoryCustomPropertiesModel(ctx, client, organization, plan.Properties, nil)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = client.Repositories.CreateOrUpdateCustomProperties(ctx, organization, repository, values)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set repository custom properties.", err.Error())
+		return
+	}
+
+	state, diags := readRepositoryCustomProperties(ctx, client, organization, repository, plan.Properties)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read reads the resource state.
+func (r *RepositoryCustomPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryCustomPropertiesModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	cpv, err := ghutil.ListCustomPropertyValues(ctx, client, organization, state.Repository.ValueString())
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get repository custom properties.", err.Error())
+		return
+	}
+
+	m, diags := toRepositoryCustomPropertiesModel(ctx, organization, state.Repository.ValueString(), cpv, state.Properties)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Update updates the resource.
+func (r *RepositoryCustomPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryCustomPropertiesModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state RepositoryCustomPropertiesModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+	repository := plan.Repository.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	removed := make([]string, 0, len(state.Properties))
+	for name := range state.Properties {
+		if _, ok := plan.Properties[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	values, diags := fromRepositoryCustomPropertiesModel(ctx, client, organization, plan.Properties, removed)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = client.Repositories.CreateOrUpdateCustomProperties(ctx, organization, repository, values)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set repository custom properties.", err.Error())
+		return
+	}
+
+	m, diags := readRepositoryCustomProperties(ctx, client, organization, repository, plan.Properties)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Delete deletes the resource.
+func (r *RepositoryCustomPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryCustomPropertiesModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	values := make([]*github.CustomPropertyValue, 0, len(state.Properties))
+	for name := range state.Properties {
+		values = append(values, &github.CustomPropertyValue{PropertyName: name})
+	}
+
+	_, err = client.Repositories.CreateOrUpdateCustomProperties(ctx, organization, state.Repository.ValueString(), values)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to remove repository custom properties.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *RepositoryCustomPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization", "repository", "property_name[,property_name]")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	properties := map[string]RepositoryCustomPropertyValueModel{}
+	for name := range strings.SplitSeq(parts[2], ",") {
+		if len(name) == 0 {
+			resp.Diagnostics.AddError("Invalid import ID.", "property_name must be non-empty")
+			return
+		}
+		properties[name] = RepositoryCustomPropertyValueModel{
+			Value:  types.StringNull(),
+			Values: types.ListNull(types.StringType),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("properties"), properties)...)
+}
+
+// readRepositoryCustomProperties reads the values of the managed properties for a repository.
+func readRepositoryCustomProperties(ctx context.Context, client *github.Client, org, repo string, managed map[string]RepositoryCustomPropertyValueModel) (RepositoryCustomPropertiesModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	cpv, err := ghutil.ListCustomPropertyValues(ctx, client, org, repo)
+	if err != nil {
+		diags.AddError("Failed to get repository custom properties.", err.Error())
+		return RepositoryCustomPropertiesModel{}, diags
+	}
+
+	return toRepositoryCustomPropertiesModel(ctx, org, repo, cpv, managed)
+}
+
+// toRepositoryCustomPropertiesModel converts the property values of a repository to the resource model, only the managed properties
+// are kept.
+func toRepositoryCustomPropertiesModel(ctx context.Context, org, repo string, cpv []*github.CustomPropertyValue, managed map[string]RepositoryCustomPropertyValueModel) (RepositoryCustomPropertiesModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	properties := make(map[string]RepositoryCustomPropertyValueModel, len(managed))
+	for _, v := range cpv {
+		current, ok := managed[v.PropertyName]
+		if !ok {
+			continue
+		}
+
+		switch value := v.Value.(type) {
+		case string:
+			properties[v.PropertyName] = RepositoryCustomPropertyValueModel{
+				Value:  types.StringValue(value),
+				Values: types.ListNull(types.StringType),
+			}
+		case []string:
+			// Keep the configured order when the values are the same to avoid a perpetual diff.
+			if !current.Values.IsNull() && !current.Values.IsUnknown() {
+				configured := make([]string, 0, len(current.Values.Elements()))
+				if d := current.Values.ElementsAs(ctx, &configured, false); d.HasError() {
+					return RepositoryCustomPropertiesModel{}, d
+				}
+
+				if slices.Equal(slices.Sorted(slices.Values(configured)), slices.Sorted(slices.Values(value))) {
+					value = configured
+				}
+			}
+
+			values, d := types.ListValueFrom(ctx, types.StringType, value)
+			if d.HasError() {
+				return RepositoryCustomPropertiesModel{}, d
+			}
+
+			properties[v.PropertyName] = RepositoryCustomPropertyValueModel{
+				Value:  types.StringNull(),
+				Values: values,
+			}
+		}
+	}
+
+	m := RepositoryCustomPropertiesModel{
+		Organization: types.StringValue(org),
+		Properties:   properties,
+		Repository:   types.StringValue(repo),
+	}
+
+	return m, diags
+}
+
+// fromRepositoryCustomPropertiesModel validates the property values against the organization property definitions and converts them
+// into the API representation; removed properties are unset.
+func fromRepositoryCustomPropertiesModel(ctx context.Context, client *github.Client, org string, properties map[string]RepositoryCustomPropertyValueModel, removed []string) ([]*github.CustomPropertyValue, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	cp, err := ghutil.ListCustomProperties(ctx, client, org)
+	if err != nil {
+		diags.AddError("Failed to get organization properties.", err.Error())
+		return nil, diags
+	}
+
+	definitions := make(map[string]PropertyModel, len(cp))
+	for _, p := range cp {
+		pm, d := toPropertyModel(ctx, p)
+		if d.HasError() {
+			return nil, d
+		}
+		definitions[pm.Name.ValueString()] = pm
+	}
+
+	values := make([]*github.CustomPropertyValue, 0, len(properties)+len(removed))
+	for name, v := range properties {
+		definition, ok := definitions[name]
+		if !ok {
+			diags.AddAttributeError(path.Root("properties").AtMapKey(name), "Unknown property.", fmt.Sprintf("property %q is not defined for organization %q", name, org))
+			continue
+		}
+
+		value, d := validateRepositoryCustomPropertyValue(ctx, definition, v)
+		if d.HasError() {
+			for _, e := range d.Errors() {
+				diags.AddAttributeError(path.Root("properties").AtMapKey(name), e.Summary(), e.Detail())
+			}
+			continue
+		}
+
+		values = append(values, &github.CustomPropertyValue{PropertyName: name, Value: value})
+	}
+
+	for _, name := range removed {
+		values = append(values, &github.CustomPropertyValue{PropertyName: name})
+	}
+
+	return values, diags
+}
+
+// validateRepositoryCustomPropertyValue validates a property value against the property definition and returns the value to send to
+// the API.
+func validateRepositoryCustomPropertyValue(ctx context.Context, p PropertyModel, v RepositoryCustomPropertyValueModel) (any, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	allowedValues := make([]string, 0, len(p.AllowedValues.Elements()))
+	if !p.AllowedValues.IsNull() && !p.AllowedValues.IsUnknown() {
+		if d := p.AllowedValues.ElementsAs(ctx, &allowedValues, false); d.HasError() {
+			return nil, d
+		}
+	}
+
+	valueType := p.ValueType.ValueString()
+	switch valueType {
+	case "multi_select":
+		if v.Values.IsNull() {
+			diags.AddError("Invalid property value.", "multi_select properties must be set using values")
+			return nil, diags
+		}
+
+		values := make([]string, 0, len(v.Values.Elements()))
+		if d := v.Values.ElementsAs(ctx, &values, false); d.HasError() {
+			return nil, d
+		}
+
+		for _, value := range values {
+			if !slices.Contains(allowedValues, value) {
+				diags.AddError("Invalid property value.", fmt.Sprintf("value %q must be one of: %s", value, strings.Join(allowedValues, ", ")))
+			}
+		}
+
+		return values, diags
+	default:
+		if v.Value.IsNull() {
+			diags.AddError("Invalid property value.", fmt.Sprintf("%s properties must be set using value", valueType))
+			return nil, diags
+		}
+
+		value := v.Value.ValueString()
+
+		switch valueType {
+		case "single_select":
+			if !slices.Contains(allowedValues, value) {
+				diags.AddError("Invalid property value.", fmt.Sprintf("value %q must be one of: %s", value, strings.Join(allowedValues, ", ")))
+			}
+		case "true_false":
+			if value != "true" && value != "false" {
+				diags.AddError("Invalid property value.", fmt.Sprintf("value %q must be one of: true, false", value))
+			}
+		}
+
+		return value, diags
+	}
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRepositoryCustomPropertiesResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("create", func(t *testing.T) {
+		name := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_organization_property" "single" {
+  organization   = "%[1]s"
+  name           = "%[2]s-single"
+  value_type     = "single_select"
+  allowed_values = ["option1", "option2"]
+}
+
+resource "github_organization_property" "multi" {
+  organization   = "%[1]s"
+  name           = "%[2]s-multi"
+  value_type     = "multi_select"
+  allowed_values = ["option1", "option2", "option3"]
+}
+
+resource "github_repository" "test" {
+  organization = "%[1]s"
+  name         = "%[2]s"
+}
+
+resource "github_repository_custom_properties" "test" {
+  organization = "%[1]s"
+  repository   = github_repository.test.name
+
+  properties = {
+    (github_organization_property.single.name) = {
+      value = "option1"
+    }
+    (github_organization_property.multi.name) = {
+      values = ["option3", "option1"]
+    }
+  }
+}
+`, accTestConfigData.Values.Organization, name),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_repository_custom_properties.test", tfjsonpath.New("organization"), knownvalue.StringExact(accTestConfigData.Values.Organization)),
+						statecheck.ExpectKnownValue("github_repository_custom_properties.test", tfjsonpath.New("repository"), knownvalue.StringExact(name)),
+						statecheck.ExpectKnownValue("github_repository_custom_properties.test", tfjsonpath.New("properties").AtMapKey(name+"-single").AtMapKey("value"), knownvalue.StringExact("option1")),
+						statecheck.ExpectKnownValue("github_repository_custom_properties.test", tfjsonpath.New("properties").AtMapKey(name+"-multi").AtMapKey("values"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("option3"),
+							knownvalue.StringExact("option1"),
+						})),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("invalid_value", func(t *testing.T) {
+		name := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_organization_property" "single" {
+  organization   = "%[1]s"
+  name           = "%[2]s-single"
+  value_type     = "single_select"
+  allowed_values = ["option1", "option2"]
+}
+
+resource "github_repository" "test" {
+  organization = "%[1]s"
+  name         = "%[2]s"
+}
+
+resource "github_repository_custom_properties" "test" {
+  organization = "%[1]s"
+  repository   = github_repository.test.name
+
+  properties = {
+    (github_organization_property.single.name) = {
+      value = "option3"
+    }
+  }
+}
+`, accTestConfigData.Values.Organization, name),
+					ExpectError: regexp.MustCompile("Invalid property value"),
+				},
+			},
+		})
+	})
+}
+
+func TestRepositoryCustomPropertiesResourceReadNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v3/repos/test-org/test-repo/properties/values", testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`))
+
+	r := &RepositoryCustomPropertiesResource{providerData: testMockProviderData(t, mux)}
+	state := testResourceState(t, r, &RepositoryCustomPropertiesModel{
+		Organization: types.StringValue("test-org"),
+		Properties: map[string]RepositoryCustomPropertyValueModel{
+			"team": {Value: types.StringValue("platform"), Values: types.ListNull(types.StringType)},
+		},
+		Repository: types.StringValue("test-repo"),
+	})
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected the custom properties to be removed from the state")
+	}
+}
+
+func TestRepositoryCustomPropertiesResourceImportState(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		id             string
+		wantOrg        string
+		wantRepo       string
+		wantProperties []string
+		wantErr        bool
+	}{
+		{name: "valid", id: "test-org:test-repo:team", wantOrg: "test-org", wantRepo: "test-repo", wantProperties: []string{"team"}},
+		{name: "multiple_properties", id: "test-org:test-repo:team,tier", wantOrg: "test-org", wantRepo: "test-repo", wantProperties: []string{"team", "tier"}},
+		{name: "missing_properties", id: "test-org:test-repo", wantErr: true},
+		{name: "empty_organization", id: ":test-repo:team", wantErr: true},
+		{name: "empty_repository", id: "test-org::team", wantErr: true},
+		{name: "empty_property", id: "test-org:test-repo:team,", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &RepositoryCustomPropertiesResource{}
+			resp := &fwresource.ImportStateResponse{State: testResourceState(t, r, nil)}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			var m RepositoryCustomPropertiesModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &m)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if m.Organization.ValueString() != tc.wantOrg || m.Repository.ValueString() != tc.wantRepo {
+				t.Fatalf("expected %s:%s, got %s:%s", tc.wantOrg, tc.wantRepo, m.Organization.ValueString(), m.Repository.ValueString())
+			}
+
+			if got := slices.Sorted(maps.Keys(m.Properties)); !slices.Equal(got, tc.wantProperties) {
+				t.Fatalf("expected properties %v, got %v", tc.wantProperties, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                = &RepositoryRulesetResource{}
+	_ resource.ResourceWithConfigure   = &RepositoryRulesetResource{}
+	_ resource.ResourceWithImportState = &RepositoryRulesetResource{}
+)
+
+// NewRepositoryRulesetResource creates a new RepositoryRulesetResource.
+func NewRepositoryRulesetResource() resource.Resource {
+	return &RepositoryRulesetResource{}
+}
+
+// RepositoryRulesetResource defines the resource implementation.
+type RepositoryRulesetResource struct {
+	providerData *GitHubProviderData
+}
+
+// RepositoryRulesetModel describes the data model.
+type RepositoryRulesetModel struct {
+	BypassActors []RulesetBypassActorModel         `tfsdk:"bypass_actors"`
+	Conditions   *RepositoryRulesetConditionsModel `tfsdk:"conditions"`
+	Enforcement  types.String                      `tfsdk:"enforcement"`
+	ID           types.Int64                       `tfsdk:"id"`
+	Name         types.String                      `tfsdk:"name"`
+	Organization types.String                      `tfsdk:"organization"`
+	Repository   types.String                      `tfsdk:"repository"`
+	Rules        *RulesetRulesModel                `tfsdk:"rules"`
+	Target       types.String                      `tfsdk:"target"`
+}
+
+// RepositoryRulesetConditionsModel describes the conditions data model.
+type RepositoryRulesetConditionsModel struct {
+	RefName *RulesetRefNameConditionModel `tfsdk:"ref_name"`
+}
+
+// Metadata returns the resource metadata.
+func (r *RepositoryRulesetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_repository_ruleset", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *RepositoryRulesetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ repository ruleset resource (`github_repository_ruleset`) allows you to manage rulesets for a _GitHub_ repository.",
+		Attributes: map[string]schema.Attribute{
+			"bypass_actors": rulesetBypassActorsAttribute(),
+			"conditions": schema.SingleNestedAttribute{
+				MarkdownDescription: "Conditions that determine which refs the ruleset applies to.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"ref_name": rulesetRefNameConditionAttribute(),
+				},
+			},
+			"enforcement": schema.StringAttribute{
+				MarkdownDescription: "Enforcement level of the ruleset. This can be one of `active`, `evaluate` or `disabled`; `evaluate` is only available with _GitHub Enterprise_.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(github.RulesetEnforcementActive), string(github.RulesetEnforcementEvaluate), string(github.RulesetEnforcementDisabled)),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique identifier of the ruleset.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the ruleset.",
+				Required:            true,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization that owns the repository.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": rulesetRulesAttribute(),
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Target of the ruleset. This can be one of `branch`, `tag` or `push`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(github.RulesetTargetBranch), string(github.RulesetTargetTag), string(github.RulesetTargetPush)),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *RepositoryRulesetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+	r.providerData = providerData
+}
+
+// Create creates the resource.
+func (r *RepositoryRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RepositoryRulesetModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+	repository := plan.Repository.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	rs, _, err := client.Repositories.CreateRuleset(ctx, organization, repository, fromRepositoryRulesetModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create repository ruleset.", err.Error())
+		return
+	}
+
+	state := toRepositoryRulesetModel(organization, repository, rs, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read reads the resource state.
+func (r *RepositoryRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryRulesetModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+	repository := state.Repository.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	rs, _, err := client.Repositories.GetRuleset(ctx, organization, repository, state.ID.ValueInt64(), false)
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get repository ruleset.", err.Error())
+		return
+	}
+
+	m := toRepositoryRulesetModel(organization, repository, rs, state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Update updates the resource.
+func (r *RepositoryRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryRulesetModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+	repository := plan.Repository.ValueString()
+	id := plan.ID.ValueInt64()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	rs, _, err := client.Repositories.UpdateRuleset(ctx, organization, repository, id, fromRepositoryRulesetModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update repository ruleset.", err.Error())
+		return
+	}
+
+	// An empty bypass actor list is omitted from the update request so it needs to be cleared explicitly.
+	if len(plan.BypassActors) == 0 && len(rs.BypassActors) != 0 {
+		_, err = client.Repositories.UpdateRulesetClearBypassActor(ctx, organization, repository, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to clear repository ruleset bypass actors.", err.Error())
+			return
+		}
+		rs.BypassActors = nil
+	}
+
+	m := toRepositoryRulesetModel(organization, repository, rs, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &m)...)
+}
+
+// Delete deletes the resource.
+func (r *RepositoryRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryRulesetModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	_, err = client.Repositories.DeleteRuleset(ctx, organization, state.Repository.ValueString(), state.ID.ValueInt64())
+	if err != nil && !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete repository ruleset.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *RepositoryRulesetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization/repository", "ruleset_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	organization, repository, err := ghutil.ParseRepository(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", "ruleset_id must be an integer")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// toRepositoryRulesetModel converts a ruleset to the resource model, the ref name patterns keep the order of the known model
+// when GitHub returns the same patterns in a different order.
+func toRepositoryRulesetModel(org, repo string, rs *github.RepositoryRuleset, known RepositoryRulesetModel) RepositoryRulesetModel {
+	m := RepositoryRulesetModel{
+		BypassActors: toRulesetBypassActorModels(rs.BypassActors),
+		Enforcement:  types.StringValue(string(rs.Enforcement)),
+		ID:           types.Int64Value(rs.GetID()),
+		Name:         types.StringValue(rs.Name),
+		Organization: types.StringValue(org),
+		Repository:   types.StringValue(repo),
+		Rules:        github.Ptr(toRulesetRulesModel(rs.Rules)),
+		Target:       types.StringNull(),
+	}
+
+	if rs.Target != nil {
+		m.Target = types.StringValue(string(*rs.Target))
+	}
+
+	var refName *github.RepositoryRulesetRefConditionParameters
+	if rs.Conditions != nil {
+		refName = rs.Conditions.RefName
+	}
+
+	if refName != nil || known.Conditions != nil {
+		var knownRefName *RulesetRefNameConditionModel
+		if known.Conditions != nil {
+			knownRefName = known.Conditions.RefName
+		}
+
+		m.Conditions = &RepositoryRulesetConditionsModel{
+			RefName: keepRulesetRefNameConditionOrder(toRulesetRefNameConditionModel(refName), knownRefName),
+		}
+	}
+
+	return m
+}
+
+func fromRepositoryRulesetModel(m RepositoryRulesetModel) github.RepositoryRuleset {
+	rs := github.RepositoryRuleset{
+		BypassActors: fromRulesetBypassActorModels(m.BypassActors),
+		Enforcement:  github.RulesetEnforcement(m.Enforcement.ValueString()),
+		Name:         m.Name.ValueString(),
+		Target:       github.Ptr(github.RulesetTarget(m.Target.ValueString())),
+		Rules:        &github.RepositoryRulesetRules{},
+	}
+
+	if m.Rules != nil {
+		rs.Rules = fromRulesetRulesModel(*m.Rules)
+	}
+
+	if c := m.Conditions; c != nil {
+		rs.Conditions = &github.RepositoryRulesetConditions{
+			RefName: fromRulesetRefNameConditionModel(c.RefName),
+		}
+	}
+
+	return rs
+}
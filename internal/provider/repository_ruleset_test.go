@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRepositoryRulesetResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("create_default", func(t *testing.T) {
+		name := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_repository_ruleset" "test" {
+  organization = "%[1]s"
+  repository   = github_repository.test.name
+  name         = "%[2]s"
+  target       = "branch"
+  enforcement  = "active"
+
+  conditions = {
+    ref_name = {
+      include = ["refs/heads/release/*", "~DEFAULT_BRANCH"]
+    }
+  }
+
+  rules = {
+    deletion                = true
+    non_fast_forward        = true
+    required_linear_history = true
+    required_signatures     = true
+
+    pull_request = {
+      required_approving_review_count = 2
+    }
+
+    required_status_checks = {
+      required_checks = [
+        { context = "test" },
+        { context = "lint" },
+      ]
+    }
+  }
+}
+`, accTestConfigData.Values.Organization, name),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("bypass_actors"), knownvalue.SetSizeExact(0)),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("conditions").AtMapKey("ref_name").AtMapKey("include"), knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("refs/heads/release/*"), knownvalue.StringExact("~DEFAULT_BRANCH")})),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("enforcement"), knownvalue.StringExact("active")),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("repository"), knownvalue.StringExact(name)),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("rules").AtMapKey("pull_request").AtMapKey("required_approving_review_count"), knownvalue.Int64Exact(2)),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("rules").AtMapKey("required_linear_history"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("rules").AtMapKey("required_signatures"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("rules").AtMapKey("required_status_checks").AtMapKey("required_checks"), knownvalue.SetSizeExact(2)),
+					},
+				},
+				{
+					ResourceName:      "github_repository_ruleset.test",
+					ImportState:       true,
+					ImportStateIdFunc: testAccRepositoryRulesetImportStateIDFunc("github_repository_ruleset.test"),
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("create_merge_queue", func(t *testing.T) {
+		name := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_repository_ruleset" "test" {
+  organization = "%[1]s"
+  repository   = github_repository.test.name
+  name         = "%[2]s"
+  target       = "branch"
+  enforcement  = "evaluate"
+
+  conditions = {
+    ref_name = {
+      include = ["~DEFAULT_BRANCH"]
+    }
+  }
+
+  rules = {
+    merge_queue = {
+      merge_method = "SQUASH"
+    }
+  }
+}
+`, accTestConfigData.Values.Organization, name),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("enforcement"), knownvalue.StringExact("evaluate")),
+						statecheck.ExpectKnownValue("github_repository_ruleset.test", tfjsonpath.New("rules").AtMapKey("merge_queue").AtMapKey("merge_method"), knownvalue.StringExact("SQUASH")),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestRepositoryRulesetResourceImportState(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		id       string
+		wantOrg  string
+		wantRepo string
+		wantID   int64
+		wantErr  bool
+	}{
+		{name: "valid", id: "test-org/test-repo:42", wantOrg: "test-org", wantRepo: "test-repo", wantID: 42},
+		{name: "missing_id", id: "test-org/test-repo", wantErr: true},
+		{name: "missing_repository", id: "test-org:42", wantErr: true},
+		{name: "empty_repository", id: "test-org/:42", wantErr: true},
+		{name: "invalid_id", id: "test-org/test-repo:abc", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &RepositoryRulesetResource{}
+			resp := &fwresource.ImportStateResponse{State: testResourceState(t, r, nil)}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			var org, repo types.String
+			var id types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization"), &org)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("repository"), &repo)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if org.ValueString() != tc.wantOrg || repo.ValueString() != tc.wantRepo || id.ValueInt64() != tc.wantID {
+				t.Fatalf("expected %s/%s:%d, got %s/%s:%d", tc.wantOrg, tc.wantRepo, tc.wantID, org.ValueString(), repo.ValueString(), id.ValueInt64())
+			}
+		})
+	}
+}
+
+func testAccRepositoryRulesetImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s:%s", rs.Primary.Attributes["organization"], rs.Primary.Attributes["repository"], rs.Primary.Attributes["id"]), nil
+	}
+}
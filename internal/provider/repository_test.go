@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/google/go-github/v74/github"
+)
+
+func TestAccRepositoryResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("create_default", func(t *testing.T) {
+		repoName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+`, accTestConfigData.Values.Organization, repoName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("allow_auto_merge"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("allow_merge_commit"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("allow_rebase_merge"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("allow_squash_merge"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("archive_on_destroy"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("delete_branch_on_merge"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("description"), knownvalue.StringExact("")),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("full_name"), knownvalue.StringExact(fmt.Sprintf("%s/%s", accTestConfigData.Values.Organization, repoName))),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("homepage"), knownvalue.StringExact("")),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("name"), knownvalue.StringExact(repoName)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("organization"), knownvalue.StringExact(accTestConfigData.Values.Organization)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("template"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("topics"), knownvalue.SetSizeExact(0)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("visibility"), knownvalue.StringExact("private")),
+					},
+				},
+				{
+					ResourceName:      "github_repository.test",
+					ImportState:       true,
+					ImportStateId:     fmt.Sprintf("%s:%s", accTestConfigData.Values.Organization, repoName),
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("create_full", func(t *testing.T) {
+		repoName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  organization           = "%s"
+  name                   = "%s"
+  description            = "Test description."
+  homepage               = "https://example.com"
+  visibility             = "public"
+  topics                 = ["test", "terraform"]
+  allow_auto_merge       = true
+  allow_merge_commit     = false
+  allow_rebase_merge     = false
+  delete_branch_on_merge = true
+}
+`, accTestConfigData.Values.Organization, repoName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("allow_auto_merge"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("allow_merge_commit"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("allow_rebase_merge"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("allow_squash_merge"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("delete_branch_on_merge"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("description"), knownvalue.StringExact("Test description.")),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("homepage"), knownvalue.StringExact("https://example.com")),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("topics"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("test"),
+							knownvalue.StringExact("terraform"),
+						})),
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("visibility"), knownvalue.StringExact("public")),
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("archive_on_destroy", func(t *testing.T) {
+		repoName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_repository" "test" {
+  organization       = "%s"
+  name               = "%s"
+  archive_on_destroy = true
+}
+`, accTestConfigData.Values.Organization, repoName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_repository.test", tfjsonpath.New("archive_on_destroy"), knownvalue.Bool(true)),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestRepositoryResourceRead(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		handler     http.HandlerFunc
+		wantRemoved bool
+	}{
+		{
+			name:    "found",
+			handler: testMockJSON(http.StatusOK, `{"id":1,"name":"test-repo","full_name":"test-org/test-repo","visibility":"private"}`),
+		},
+		{
+			name:        "not_found",
+			handler:     testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`),
+			wantRemoved: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mux := http.NewServeMux()
+			mux.Handle("GET /api/v3/repos/test-org/test-repo", tc.handler)
+
+			r := &RepositoryResource{providerData: testMockProviderData(t, mux)}
+			state := testResourceState(t, r, testRepositoryModel())
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Fatalf("expected removed %t, got %t", tc.wantRemoved, resp.State.Raw.IsNull())
+			}
+		})
+	}
+}
+
+func TestRepositoryResourceCreateAutoInit(t *testing.T) {
+	for _, autoInit := range []bool{false, true} {
+		t.Run(fmt.Sprint(autoInit), func(t *testing.T) {
+			ctx := context.Background()
+
+			var got github.Repository
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /api/v3/orgs/test-org/repos", func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Error(err)
+				}
+				testMockJSON(http.StatusCreated, `{"id":1,"name":"test-repo","full_name":"test-org/test-repo","visibility":"private"}`)(w, r)
+			})
+			mux.Handle("PUT /api/v3/repos/test-org/test-repo/topics", testMockJSON(http.StatusOK, `{"names":[]}`))
+
+			r := &RepositoryResource{providerData: testMockProviderData(t, mux)}
+
+			m := testRepositoryModel()
+			m.AutoInit = types.BoolValue(autoInit)
+			m.FullName = types.StringUnknown()
+			m.HTMLURL = types.StringUnknown()
+			m.ID = types.Int64Unknown()
+			plan := testResourceState(t, r, m)
+
+			resp := &fwresource.CreateResponse{State: testResourceState(t, r, nil)}
+			r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if got.GetAutoInit() != autoInit {
+				t.Fatalf("expected auto init %t, got %t", autoInit, got.GetAutoInit())
+			}
+
+			var state RepositoryModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			if state.AutoInit.ValueBool() != autoInit {
+				t.Fatalf("expected auto init %t in the state, got %s", autoInit, state.AutoInit)
+			}
+		})
+	}
+}
+
+func TestRepositoryResourceCreateFromTemplate(t *testing.T) {
+	timeout, interval := repositoryGenerationTimeout, repositoryGenerationInterval
+	repositoryGenerationTimeout, repositoryGenerationInterval = time.Second, time.Millisecond
+	t.Cleanup(func() { repositoryGenerationTimeout, repositoryGenerationInterval = timeout, interval })
+
+	const repo = `{"id":1,"name":"test-repo","full_name":"test-org/test-repo","visibility":"private"}`
+
+	for _, tc := range []struct {
+		name       string
+		notReady   int32
+		editStatus int
+		wantErr    bool
+		wantEdits  int32
+		wantTopics bool
+	}{
+		{name: "available", editStatus: http.StatusOK, wantEdits: 1},
+		{name: "generating", notReady: 2, editStatus: http.StatusOK, wantEdits: 3},
+		{name: "edit_failed", editStatus: http.StatusUnprocessableEntity, wantErr: true, wantEdits: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var edits atomic.Int32
+			mux := http.NewServeMux()
+			mux.Handle("POST /api/v3/repos/test-org/test-template/generate", testMockJSON(http.StatusCreated, repo))
+			mux.HandleFunc("PATCH /api/v3/repos/test-org/test-repo", func(w http.ResponseWriter, r *http.Request) {
+				switch n := edits.Add(1); {
+				case n <= tc.notReady && n%2 == 1:
+					testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`)(w, r)
+				case n <= tc.notReady:
+					testMockJSON(http.StatusConflict, `{"message":"Repository is being generated"}`)(w, r)
+				case tc.editStatus != http.StatusOK:
+					testMockJSON(tc.editStatus, `{"message":"Validation Failed"}`)(w, r)
+				default:
+					testMockJSON(http.StatusOK, repo)(w, r)
+				}
+			})
+			mux.Handle("PUT /api/v3/repos/test-org/test-repo/topics", testMockJSON(http.StatusOK, `{"names":[]}`))
+
+			r := &RepositoryResource{providerData: testMockProviderData(t, mux)}
+
+			m := testRepositoryModel()
+			m.FullName = types.StringUnknown()
+			m.HTMLURL = types.StringUnknown()
+			m.ID = types.Int64Unknown()
+			m.Template = &RepositoryTemplateModel{IncludeAllBranches: types.BoolValue(false), Owner: types.StringValue("test-org"), Repository: types.StringValue("test-template")}
+			state := testResourceState(t, r, m)
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Schema.Type().TerraformType(ctx), nil)}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+
+			if got := edits.Load(); got != tc.wantEdits {
+				t.Fatalf("expected %d edits, got %d", tc.wantEdits, got)
+			}
+
+			// The repository exists even if the edit failed, so it must be in the state.
+			var got RepositoryModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+			if got.ID.ValueInt64() != 1 || got.Template == nil {
+				t.Fatalf("expected repository 1 created from template, got %d", got.ID.ValueInt64())
+			}
+		})
+	}
+}
+
+// testRepositoryModel returns a repository model with the default values.
+func testRepositoryModel() RepositoryModel {
+	return RepositoryModel{
+		AllowAutoMerge:      types.BoolValue(false),
+		AllowMergeCommit:    types.BoolValue(true),
+		AllowRebaseMerge:    types.BoolValue(true),
+		AllowSquashMerge:    types.BoolValue(true),
+		ArchiveOnDestroy:    types.BoolValue(false),
+		AutoInit:            types.BoolValue(false),
+		DefaultBranch:       types.StringValue("main"),
+		DeleteBranchOnMerge: types.BoolValue(false),
+		Description:         types.StringValue(""),
+		FullName:            types.StringValue("test-org/test-repo"),
+		Homepage:            types.StringValue(""),
+		HTMLURL:             types.StringValue(""),
+		ID:                  types.Int64Value(1),
+		Name:                types.StringValue("test-repo"),
+		Organization:        types.StringValue("test-org"),
+		Topics:              types.SetValueMust(types.StringType, nil),
+		Visibility:          types.StringValue("private"),
+	}
+}
@@ -0,0 +1,964 @@
+package provider
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/google/go-github/v74/github"
+)
+
+// RulesetBypassActorModel describes the ruleset bypass actor data model.
+type RulesetBypassActorModel struct {
+	ActorID    types.Int64  `tfsdk:"actor_id"`
+	ActorType  types.String `tfsdk:"actor_type"`
+	BypassMode types.String `tfsdk:"bypass_mode"`
+}
+
+// RulesetRefNameConditionModel describes the ruleset ref name condition data model.
+type RulesetRefNameConditionModel struct {
+	Exclude []string `tfsdk:"exclude"`
+	Include []string `tfsdk:"include"`
+}
+
+// RulesetRulesModel describes the ruleset rules data model.
+type RulesetRulesModel struct {
+	BranchNamePattern        *RulesetPatternRuleModel                  `tfsdk:"branch_name_pattern"`
+	CodeScanning             *RulesetCodeScanningRuleModel             `tfsdk:"code_scanning"`
+	CommitAuthorEmailPattern *RulesetPatternRuleModel                  `tfsdk:"commit_author_email_pattern"`
+	CommitMessagePattern     *RulesetPatternRuleModel                  `tfsdk:"commit_message_pattern"`
+	CommitterEmailPattern    *RulesetPatternRuleModel                  `tfsdk:"committer_email_pattern"`
+	Creation                 types.Bool                                `tfsdk:"creation"`
+	Deletion                 types.Bool                                `tfsdk:"deletion"`
+	FileExtensionRestriction *RulesetFileExtensionRestrictionRuleModel `tfsdk:"file_extension_restriction"`
+	FilePathRestriction      *RulesetFilePathRestrictionRuleModel      `tfsdk:"file_path_restriction"`
+	MaxFilePathLength        *RulesetMaxFilePathLengthRuleModel        `tfsdk:"max_file_path_length"`
+	MaxFileSize              *RulesetMaxFileSizeRuleModel              `tfsdk:"max_file_size"`
+	MergeQueue               *RulesetMergeQueueRuleModel               `tfsdk:"merge_queue"`
+	NonFastForward           types.Bool                                `tfsdk:"non_fast_forward"`
+	PullRequest              *RulesetPullRequestRuleModel              `tfsdk:"pull_request"`
+	RequiredDeployments      *RulesetRequiredDeploymentsRuleModel      `tfsdk:"required_deployments"`
+	RequiredLinearHistory    types.Bool                                `tfsdk:"required_linear_history"`
+	RequiredSignatures       types.Bool                                `tfsdk:"required_signatures"`
+	RequiredStatusChecks     *RulesetRequiredStatusChecksRuleModel     `tfsdk:"required_status_checks"`
+	TagNamePattern           *RulesetPatternRuleModel                  `tfsdk:"tag_name_pattern"`
+	Update                   *RulesetUpdateRuleModel                   `tfsdk:"update"`
+	Workflows                *RulesetWorkflowsRuleModel                `tfsdk:"workflows"`
+}
+
+// RulesetPatternRuleModel describes the pattern rule data model.
+type RulesetPatternRuleModel struct {
+	Name     types.String `tfsdk:"name"`
+	Negate   types.Bool   `tfsdk:"negate"`
+	Operator types.String `tfsdk:"operator"`
+	Pattern  types.String `tfsdk:"pattern"`
+}
+
+// RulesetCodeScanningRuleModel describes the code scanning rule data model.
+type RulesetCodeScanningRuleModel struct {
+	Tools []RulesetCodeScanningToolModel `tfsdk:"tools"`
+}
+
+// RulesetCodeScanningToolModel describes the code scanning tool data model.
+type RulesetCodeScanningToolModel struct {
+	AlertsThreshold         types.String `tfsdk:"alerts_threshold"`
+	SecurityAlertsThreshold types.String `tfsdk:"security_alerts_threshold"`
+	Tool                    types.String `tfsdk:"tool"`
+}
+
+// RulesetFileExtensionRestrictionRuleModel describes the file extension restriction rule data model.
+type RulesetFileExtensionRestrictionRuleModel struct {
+	RestrictedFileExtensions []string `tfsdk:"restricted_file_extensions"`
+}
+
+// RulesetFilePathRestrictionRuleModel describes the file path restriction rule data model.
+type RulesetFilePathRestrictionRuleModel struct {
+	RestrictedFilePaths []string `tfsdk:"restricted_file_paths"`
+}
+
+// RulesetMaxFilePathLengthRuleModel describes the max file path length rule data model.
+type RulesetMaxFilePathLengthRuleModel struct {
+	MaxFilePathLength types.Int64 `tfsdk:"max_file_path_length"`
+}
+
+// RulesetMaxFileSizeRuleModel describes the max file size rule data model.
+type RulesetMaxFileSizeRuleModel struct {
+	MaxFileSize types.Int64 `tfsdk:"max_file_size"`
+}
+
+// RulesetMergeQueueRuleModel describes the merge queue rule data model.
+type RulesetMergeQueueRuleModel struct {
+	CheckResponseTimeoutMinutes  types.Int64  `tfsdk:"check_response_timeout_minutes"`
+	GroupingStrategy             types.String `tfsdk:"grouping_strategy"`
+	MaxEntriesToBuild            types.Int64  `tfsdk:"max_entries_to_build"`
+	MaxEntriesToMerge            types.Int64  `tfsdk:"max_entries_to_merge"`
+	MergeMethod                  types.String `tfsdk:"merge_method"`
+	MinEntriesToMerge            types.Int64  `tfsdk:"min_entries_to_merge"`
+	MinEntriesToMergeWaitMinutes types.Int64  `tfsdk:"min_entries_to_merge_wait_minutes"`
+}
+
+// RulesetPullRequestRuleModel describes the pull request rule data model.
+type RulesetPullRequestRuleModel struct {
+	AllowedMergeMethods            []string    `tfsdk:"allowed_merge_methods"`
+	DismissStaleReviewsOnPush      types.Bool  `tfsdk:"dismiss_stale_reviews_on_push"`
+	RequireCodeOwnerReview         types.Bool  `tfsdk:"require_code_owner_review"`
+	RequireLastPushApproval        types.Bool  `tfsdk:"require_last_push_approval"`
+	RequiredApprovingReviewCount   types.Int64 `tfsdk:"required_approving_review_count"`
+	RequiredReviewThreadResolution types.Bool  `tfsdk:"required_review_thread_resolution"`
+}
+
+// RulesetRequiredDeploymentsRuleModel describes the required deployments rule data model.
+type RulesetRequiredDeploymentsRuleModel struct {
+	Environments []string `tfsdk:"environments"`
+}
+
+// RulesetRequiredStatusChecksRuleModel describes the required status checks rule data model.
+type RulesetRequiredStatusChecksRuleModel struct {
+	DoNotEnforceOnCreate types.Bool                        `tfsdk:"do_not_enforce_on_create"`
+	RequiredChecks       []RulesetRequiredStatusCheckModel `tfsdk:"required_checks"`
+	Strict               types.Bool                        `tfsdk:"strict"`
+}
+
+// RulesetRequiredStatusCheckModel describes the required status check data model.
+type RulesetRequiredStatusCheckModel struct {
+	Context       types.String `tfsdk:"context"`
+	IntegrationID types.Int64  `tfsdk:"integration_id"`
+}
+
+// RulesetUpdateRuleModel describes the update rule data model.
+type RulesetUpdateRuleModel struct {
+	AllowsFetchAndMerge types.Bool `tfsdk:"allows_fetch_and_merge"`
+}
+
+// RulesetWorkflowsRuleModel describes the workflows rule data model.
+type RulesetWorkflowsRuleModel struct {
+	DoNotEnforceOnCreate types.Bool             `tfsdk:"do_not_enforce_on_create"`
+	Workflows            []RulesetWorkflowModel `tfsdk:"workflows"`
+}
+
+// RulesetWorkflowModel describes the workflow data model.
+type RulesetWorkflowModel struct {
+	Path         types.String `tfsdk:"path"`
+	Ref          types.String `tfsdk:"ref"`
+	RepositoryID types.Int64  `tfsdk:"repository_id"`
+	SHA          types.String `tfsdk:"sha"`
+}
+
+// rulesetBypassActorsAttribute returns the schema attribute for ruleset bypass actors.
+func rulesetBypassActorsAttribute() schema.Attribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "Actors that can bypass the ruleset.",
+		Optional:            true,
+		Computed:            true,
+		Default:             setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: rulesetBypassActorAttrTypes()}, []attr.Value{})),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"actor_id": schema.Int64Attribute{
+					MarkdownDescription: "ID of the actor; this is the team ID for `Team`, the app ID for `Integration`, the role ID for `RepositoryRole` and should be `1` for `OrganizationAdmin`.",
+					Optional:            true,
+				},
+				"actor_type": schema.StringAttribute{
+					MarkdownDescription: "Type of the actor. This can be one of `Integration`, `OrganizationAdmin`, `RepositoryRole`, `Team` or `DeployKey`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(string(github.BypassActorTypeIntegration), string(github.BypassActorTypeOrganizationAdmin), string(github.BypassActorTypeRepositoryRole), string(github.BypassActorTypeTeam), string(github.BypassActorTypeDeployKey)),
+					},
+				},
+				"bypass_mode": schema.StringAttribute{
+					MarkdownDescription: "When the actor can bypass the ruleset. This can be one of `always` or `pull_request`; defaults to `always`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(string(github.BypassModeAlways)),
+					Validators: []validator.String{
+						stringvalidator.OneOf(string(github.BypassModeAlways), string(github.BypassModePullRequest)),
+					},
+				},
+			},
+		},
+	}
+}
+
+// rulesetBypassActorAttrTypes returns the attribute types of a ruleset bypass actor.
+func rulesetBypassActorAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"actor_id":    types.Int64Type,
+		"actor_type":  types.StringType,
+		"bypass_mode": types.StringType,
+	}
+}
+
+// rulesetRefNameConditionAttribute returns the schema attribute for a ruleset ref name condition.
+func rulesetRefNameConditionAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Ref names the ruleset applies to; this is required for `branch` and `tag` rulesets.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"exclude": schema.ListAttribute{
+				MarkdownDescription: "Ref names or patterns to exclude.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(emptyStringList()),
+			},
+			"include": schema.ListAttribute{
+				MarkdownDescription: "Ref names or patterns to include; `~DEFAULT_BRANCH` and `~ALL` are supported.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+// rulesetRulesAttribute returns the schema attribute for the ruleset rules.
+func rulesetRulesAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Rules enforced by the ruleset.",
+		Required:            true,
+		Attributes: map[string]schema.Attribute{
+			"branch_name_pattern": rulesetPatternRuleAttribute("Branch name pattern the ref must match."),
+			"code_scanning": schema.SingleNestedAttribute{
+				MarkdownDescription: "Code scanning results required before the ref can be updated.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"tools": schema.SetNestedAttribute{
+						MarkdownDescription: "Code scanning tools and their alert thresholds.",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"alerts_threshold": schema.StringAttribute{
+									MarkdownDescription: "Severity level of alerts that block the update. This can be one of `none`, `errors`, `errors_and_warnings` or `all`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("none", "errors", "errors_and_warnings", "all"),
+									},
+								},
+								"security_alerts_threshold": schema.StringAttribute{
+									MarkdownDescription: "Severity level of security alerts that block the update. This can be one of `none`, `critical`, `high_or_higher`, `medium_or_higher` or `all`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf("none", "critical", "high_or_higher", "medium_or_higher", "all"),
+									},
+								},
+								"tool": schema.StringAttribute{
+									MarkdownDescription: "Name of the code scanning tool.",
+									Required:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"commit_author_email_pattern": rulesetPatternRuleAttribute("Pattern the commit author email must match."),
+			"commit_message_pattern":      rulesetPatternRuleAttribute("Pattern the commit message must match."),
+			"committer_email_pattern":     rulesetPatternRuleAttribute("Pattern the committer email must match."),
+			"creation": schema.BoolAttribute{
+				MarkdownDescription: "If only users with bypass permission can create matching refs.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion": schema.BoolAttribute{
+				MarkdownDescription: "If only users with bypass permission can delete matching refs.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"file_extension_restriction": schema.SingleNestedAttribute{
+				MarkdownDescription: "Prevent commits that include files with the specified extensions.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"restricted_file_extensions": schema.SetAttribute{
+						MarkdownDescription: "File extensions that can't be pushed.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"file_path_restriction": schema.SingleNestedAttribute{
+				MarkdownDescription: "Prevent commits that include changes to the specified file paths.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"restricted_file_paths": schema.SetAttribute{
+						MarkdownDescription: "File paths that can't be pushed.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"max_file_path_length": schema.SingleNestedAttribute{
+				MarkdownDescription: "Prevent commits that include file paths exceeding the specified length.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_file_path_length": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of characters allowed in a file path.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 256),
+						},
+					},
+				},
+			},
+			"max_file_size": schema.SingleNestedAttribute{
+				MarkdownDescription: "Prevent commits that include files exceeding the specified size.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_file_size": schema.Int64Attribute{
+						MarkdownDescription: "Maximum file size in megabytes.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+				},
+			},
+			"merge_queue": schema.SingleNestedAttribute{
+				MarkdownDescription: "Require merges to be performed through a merge queue.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"check_response_timeout_minutes": schema.Int64Attribute{
+						MarkdownDescription: "Maximum time in minutes for a required status check to report a conclusion; defaults to `60`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(60),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 360),
+						},
+					},
+					"grouping_strategy": schema.StringAttribute{
+						MarkdownDescription: "Strategy for grouping entries. This can be one of `ALLGREEN` or `HEADGREEN`; defaults to `ALLGREEN`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(github.MergeGroupingStrategyAllGreen)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(string(github.MergeGroupingStrategyAllGreen), string(github.MergeGroupingStrategyHeadGreen)),
+						},
+					},
+					"max_entries_to_build": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of queued pull requests requesting checks at the same time; defaults to `5`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(5),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"max_entries_to_merge": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of pull requests that will be merged together in a group; defaults to `5`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(5),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"merge_method": schema.StringAttribute{
+						MarkdownDescription: "Method to use when merging changes from queued pull requests. This can be one of `MERGE`, `SQUASH` or `REBASE`; defaults to `MERGE`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(github.MergeQueueMergeMethodMerge)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(string(github.MergeQueueMergeMethodMerge), string(github.MergeQueueMergeMethodSquash), string(github.MergeQueueMergeMethodRebase)),
+						},
+					},
+					"min_entries_to_merge": schema.Int64Attribute{
+						MarkdownDescription: "Minimum number of pull requests that will be merged together in a group; defaults to `1`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"min_entries_to_merge_wait_minutes": schema.Int64Attribute{
+						MarkdownDescription: "Time in minutes the merge queue should wait after the first pull request is added for the minimum group size to be met; defaults to `5`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(5),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 360),
+						},
+					},
+				},
+			},
+			"non_fast_forward": schema.BoolAttribute{
+				MarkdownDescription: "If force pushes to matching refs are prevented.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"pull_request": schema.SingleNestedAttribute{
+				MarkdownDescription: "Require all commits be made to a non-target branch and submitted via a pull request before they can be merged.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"allowed_merge_methods": schema.SetAttribute{
+						MarkdownDescription: "Merge methods allowed for pull requests. This can contain `merge`, `squash` and `rebase`; defaults to all of them.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+							types.StringValue(string(github.PullRequestMergeMethodMerge)),
+							types.StringValue(string(github.PullRequestMergeMethodSquash)),
+							types.StringValue(string(github.PullRequestMergeMethodRebase)),
+						})),
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(string(github.PullRequestMergeMethodMerge), string(github.PullRequestMergeMethodSquash), string(github.PullRequestMergeMethodRebase))),
+						},
+					},
+					"dismiss_stale_reviews_on_push": schema.BoolAttribute{
+						MarkdownDescription: "If new, reviewable commits pushed will dismiss previous pull request review approvals.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"require_code_owner_review": schema.BoolAttribute{
+						MarkdownDescription: "If an approving review is required from code owners for pull requests that modify owned files.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"require_last_push_approval": schema.BoolAttribute{
+						MarkdownDescription: "If the most recent reviewable push must be approved by someone other than the person who pushed it.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"required_approving_review_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of approving reviews required before a pull request can be merged; defaults to `0`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 10),
+						},
+					},
+					"required_review_thread_resolution": schema.BoolAttribute{
+						MarkdownDescription: "If all conversations on code must be resolved before a pull request can be merged.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"required_deployments": schema.SingleNestedAttribute{
+				MarkdownDescription: "Require deployments to succeed to the specified environments before refs can be pushed.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"environments": schema.SetAttribute{
+						MarkdownDescription: "Environments that must be successfully deployed to.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"required_linear_history": schema.BoolAttribute{
+				MarkdownDescription: "If merge commits are prevented from being pushed to matching refs.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"required_signatures": schema.BoolAttribute{
+				MarkdownDescription: "If commits pushed to matching refs must have verified signatures.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"required_status_checks": schema.SingleNestedAttribute{
+				MarkdownDescription: "Require status checks to pass before refs can be updated.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"do_not_enforce_on_create": schema.BoolAttribute{
+						MarkdownDescription: "If refs can be created even if the status checks would otherwise fail.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"required_checks": schema.SetNestedAttribute{
+						MarkdownDescription: "Status checks that are required.",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"context": schema.StringAttribute{
+									MarkdownDescription: "Name of the status check context.",
+									Required:            true,
+								},
+								"integration_id": schema.Int64Attribute{
+									MarkdownDescription: "ID of the integration that must provide the status check.",
+									Optional:            true,
+								},
+							},
+						},
+					},
+					"strict": schema.BoolAttribute{
+						MarkdownDescription: "If pull requests must be tested with the latest code before they can be merged.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"tag_name_pattern": rulesetPatternRuleAttribute("Tag name pattern the ref must match."),
+			"update": schema.SingleNestedAttribute{
+				MarkdownDescription: "Only allow users with bypass permission to update matching refs.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"allows_fetch_and_merge": schema.BoolAttribute{
+						MarkdownDescription: "If the branch can pull changes from its upstream repository.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"workflows": schema.SingleNestedAttribute{
+				MarkdownDescription: "Require workflows to pass before refs can be updated.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"do_not_enforce_on_create": schema.BoolAttribute{
+						MarkdownDescription: "If refs can be created even if the workflows would otherwise fail.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"workflows": schema.SetNestedAttribute{
+						MarkdownDescription: "Workflows that must pass.",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"path": schema.StringAttribute{
+									MarkdownDescription: "Path to the workflow file.",
+									Required:            true,
+								},
+								"ref": schema.StringAttribute{
+									MarkdownDescription: "Ref (branch or tag) of the workflow file.",
+									Optional:            true,
+								},
+								"repository_id": schema.Int64Attribute{
+									MarkdownDescription: "ID of the repository containing the workflow file.",
+									Required:            true,
+								},
+								"sha": schema.StringAttribute{
+									MarkdownDescription: "Commit SHA of the workflow file.",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// rulesetPatternRuleAttribute returns the schema attribute for a pattern rule.
+func rulesetPatternRuleAttribute(description string) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the rule.",
+				Optional:            true,
+			},
+			"negate": schema.BoolAttribute{
+				MarkdownDescription: "If the rule fails when the pattern matches.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "Operator to use for matching. This can be one of `starts_with`, `ends_with`, `contains` or `regex`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(github.PatternRuleOperatorStartsWith), string(github.PatternRuleOperatorEndsWith), string(github.PatternRuleOperatorContains), string(github.PatternRuleOperatorRegex)),
+				},
+			},
+			"pattern": schema.StringAttribute{
+				MarkdownDescription: "Pattern to match with.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// emptyStringList returns an empty, non-null string list.
+func emptyStringList() basetypes.ListValue {
+	return types.ListValueMust(types.StringType, []attr.Value{})
+}
+
+// emptyIfNil returns an empty slice if s is nil so that it isn't converted into a null value.
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func toRulesetBypassActorModels(actors []*github.BypassActor) []RulesetBypassActorModel {
+	m := make([]RulesetBypassActorModel, 0, len(actors))
+	for _, a := range actors {
+		ba := RulesetBypassActorModel{
+			ActorID:    types.Int64PointerValue(a.ActorID),
+			ActorType:  types.StringNull(),
+			BypassMode: types.StringNull(),
+		}
+
+		if a.ActorType != nil {
+			ba.ActorType = types.StringValue(string(*a.ActorType))
+		}
+
+		if a.BypassMode != nil {
+			ba.BypassMode = types.StringValue(string(*a.BypassMode))
+		}
+
+		m = append(m, ba)
+	}
+
+	return m
+}
+
+func fromRulesetBypassActorModels(m []RulesetBypassActorModel) []*github.BypassActor {
+	actors := make([]*github.BypassActor, 0, len(m))
+	for _, a := range m {
+		actors = append(actors, &github.BypassActor{
+			ActorID:    a.ActorID.ValueInt64Pointer(),
+			ActorType:  github.Ptr(github.BypassActorType(a.ActorType.ValueString())),
+			BypassMode: github.Ptr(github.BypassMode(a.BypassMode.ValueString())),
+		})
+	}
+
+	return actors
+}
+
+func toRulesetRefNameConditionModel(c *github.RepositoryRulesetRefConditionParameters) *RulesetRefNameConditionModel {
+	if c == nil {
+		return nil
+	}
+
+	return &RulesetRefNameConditionModel{
+		Exclude: emptyIfNil(c.Exclude),
+		Include: emptyIfNil(c.Include),
+	}
+}
+
+func fromRulesetRefNameConditionModel(m *RulesetRefNameConditionModel) *github.RepositoryRulesetRefConditionParameters {
+	if m == nil {
+		return nil
+	}
+
+	return &github.RepositoryRulesetRefConditionParameters{
+		Exclude: emptyIfNil(m.Exclude),
+		Include: emptyIfNil(m.Include),
+	}
+}
+
+// keepRulesetRefNameConditionOrder returns the ref name condition with the include and exclude patterns in the order of the
+// known condition if they contain the same patterns, as the order isn't significant.
+func keepRulesetRefNameConditionOrder(m, known *RulesetRefNameConditionModel) *RulesetRefNameConditionModel {
+	if m == nil || known == nil {
+		return m
+	}
+
+	return &RulesetRefNameConditionModel{
+		Exclude: keepOrder(m.Exclude, known.Exclude),
+		Include: keepOrder(m.Include, known.Include),
+	}
+}
+
+// keepOrder returns the known values if they contain the same values as s in a different order, otherwise s is returned.
+func keepOrder(s, known []string) []string {
+	if len(s) != len(known) {
+		return s
+	}
+
+	if slices.Equal(slices.Sorted(slices.Values(s)), slices.Sorted(slices.Values(known))) {
+		return known
+	}
+
+	return s
+}
+
+func toRulesetRulesModel(r *github.RepositoryRulesetRules) RulesetRulesModel {
+	if r == nil {
+		r = &github.RepositoryRulesetRules{}
+	}
+
+	m := RulesetRulesModel{
+		BranchNamePattern:        toRulesetPatternRuleModel(r.BranchNamePattern),
+		CommitAuthorEmailPattern: toRulesetPatternRuleModel(r.CommitAuthorEmailPattern),
+		CommitMessagePattern:     toRulesetPatternRuleModel(r.CommitMessagePattern),
+		CommitterEmailPattern:    toRulesetPatternRuleModel(r.CommitterEmailPattern),
+		Creation:                 types.BoolValue(r.Creation != nil),
+		Deletion:                 types.BoolValue(r.Deletion != nil),
+		NonFastForward:           types.BoolValue(r.NonFastForward != nil),
+		RequiredLinearHistory:    types.BoolValue(r.RequiredLinearHistory != nil),
+		RequiredSignatures:       types.BoolValue(r.RequiredSignatures != nil),
+		TagNamePattern:           toRulesetPatternRuleModel(r.TagNamePattern),
+	}
+
+	if p := r.CodeScanning; p != nil {
+		tools := make([]RulesetCodeScanningToolModel, 0, len(p.CodeScanningTools))
+		for _, t := range p.CodeScanningTools {
+			tools = append(tools, RulesetCodeScanningToolModel{
+				AlertsThreshold:         types.StringValue(string(t.AlertsThreshold)),
+				SecurityAlertsThreshold: types.StringValue(string(t.SecurityAlertsThreshold)),
+				Tool:                    types.StringValue(t.Tool),
+			})
+		}
+		m.CodeScanning = &RulesetCodeScanningRuleModel{Tools: tools}
+	}
+
+	if p := r.FileExtensionRestriction; p != nil {
+		m.FileExtensionRestriction = &RulesetFileExtensionRestrictionRuleModel{RestrictedFileExtensions: emptyIfNil(p.RestrictedFileExtensions)}
+	}
+
+	if p := r.FilePathRestriction; p != nil {
+		m.FilePathRestriction = &RulesetFilePathRestrictionRuleModel{RestrictedFilePaths: emptyIfNil(p.RestrictedFilePaths)}
+	}
+
+	if p := r.MaxFilePathLength; p != nil {
+		m.MaxFilePathLength = &RulesetMaxFilePathLengthRuleModel{MaxFilePathLength: types.Int64Value(int64(p.MaxFilePathLength))}
+	}
+
+	if p := r.MaxFileSize; p != nil {
+		m.MaxFileSize = &RulesetMaxFileSizeRuleModel{MaxFileSize: types.Int64Value(p.MaxFileSize)}
+	}
+
+	if p := r.MergeQueue; p != nil {
+		m.MergeQueue = &RulesetMergeQueueRuleModel{
+			CheckResponseTimeoutMinutes:  types.Int64Value(int64(p.CheckResponseTimeoutMinutes)),
+			GroupingStrategy:             types.StringValue(string(p.GroupingStrategy)),
+			MaxEntriesToBuild:            types.Int64Value(int64(p.MaxEntriesToBuild)),
+			MaxEntriesToMerge:            types.Int64Value(int64(p.MaxEntriesToMerge)),
+			MergeMethod:                  types.StringValue(string(p.MergeMethod)),
+			MinEntriesToMerge:            types.Int64Value(int64(p.MinEntriesToMerge)),
+			MinEntriesToMergeWaitMinutes: types.Int64Value(int64(p.MinEntriesToMergeWaitMinutes)),
+		}
+	}
+
+	if p := r.PullRequest; p != nil {
+		methods := make([]string, 0, len(p.AllowedMergeMethods))
+		for _, mm := range p.AllowedMergeMethods {
+			methods = append(methods, string(mm))
+		}
+
+		m.PullRequest = &RulesetPullRequestRuleModel{
+			AllowedMergeMethods:            methods,
+			DismissStaleReviewsOnPush:      types.BoolValue(p.DismissStaleReviewsOnPush),
+			RequireCodeOwnerReview:         types.BoolValue(p.RequireCodeOwnerReview),
+			RequireLastPushApproval:        types.BoolValue(p.RequireLastPushApproval),
+			RequiredApprovingReviewCount:   types.Int64Value(int64(p.RequiredApprovingReviewCount)),
+			RequiredReviewThreadResolution: types.BoolValue(p.RequiredReviewThreadResolution),
+		}
+	}
+
+	if p := r.RequiredDeployments; p != nil {
+		m.RequiredDeployments = &RulesetRequiredDeploymentsRuleModel{Environments: emptyIfNil(p.RequiredDeploymentEnvironments)}
+	}
+
+	if p := r.RequiredStatusChecks; p != nil {
+		checks := make([]RulesetRequiredStatusCheckModel, 0, len(p.RequiredStatusChecks))
+		for _, c := range p.RequiredStatusChecks {
+			checks = append(checks, RulesetRequiredStatusCheckModel{
+				Context:       types.StringValue(c.Context),
+				IntegrationID: types.Int64PointerValue(c.IntegrationID),
+			})
+		}
+
+		m.RequiredStatusChecks = &RulesetRequiredStatusChecksRuleModel{
+			DoNotEnforceOnCreate: types.BoolValue(p.GetDoNotEnforceOnCreate()),
+			RequiredChecks:       checks,
+			Strict:               types.BoolValue(p.StrictRequiredStatusChecksPolicy),
+		}
+	}
+
+	if p := r.Update; p != nil {
+		m.Update = &RulesetUpdateRuleModel{AllowsFetchAndMerge: types.BoolValue(p.UpdateAllowsFetchAndMerge)}
+	}
+
+	if p := r.Workflows; p != nil {
+		workflows := make([]RulesetWorkflowModel, 0, len(p.Workflows))
+		for _, w := range p.Workflows {
+			workflows = append(workflows, RulesetWorkflowModel{
+				Path:         types.StringValue(w.Path),
+				Ref:          types.StringPointerValue(w.Ref),
+				RepositoryID: types.Int64PointerValue(w.RepositoryID),
+				SHA:          types.StringPointerValue(w.SHA),
+			})
+		}
+
+		m.Workflows = &RulesetWorkflowsRuleModel{
+			DoNotEnforceOnCreate: types.BoolValue(p.GetDoNotEnforceOnCreate()),
+			Workflows:            workflows,
+		}
+	}
+
+	return m
+}
+
+func fromRulesetRulesModel(m RulesetRulesModel) *github.RepositoryRulesetRules {
+	r := &github.RepositoryRulesetRules{
+		BranchNamePattern:        fromRulesetPatternRuleModel(m.BranchNamePattern),
+		CommitAuthorEmailPattern: fromRulesetPatternRuleModel(m.CommitAuthorEmailPattern),
+		CommitMessagePattern:     fromRulesetPatternRuleModel(m.CommitMessagePattern),
+		CommitterEmailPattern:    fromRulesetPatternRuleModel(m.CommitterEmailPattern),
+		TagNamePattern:           fromRulesetPatternRuleModel(m.TagNamePattern),
+	}
+
+	if m.Creation.ValueBool() {
+		r.Creation = &github.EmptyRuleParameters{}
+	}
+
+	if m.Deletion.ValueBool() {
+		r.Deletion = &github.EmptyRuleParameters{}
+	}
+
+	if m.NonFastForward.ValueBool() {
+		r.NonFastForward = &github.EmptyRuleParameters{}
+	}
+
+	if m.RequiredLinearHistory.ValueBool() {
+		r.RequiredLinearHistory = &github.EmptyRuleParameters{}
+	}
+
+	if m.RequiredSignatures.ValueBool() {
+		r.RequiredSignatures = &github.EmptyRuleParameters{}
+	}
+
+	if p := m.CodeScanning; p != nil {
+		tools := make([]*github.RuleCodeScanningTool, 0, len(p.Tools))
+		for _, t := range p.Tools {
+			tools = append(tools, &github.RuleCodeScanningTool{
+				AlertsThreshold:         github.CodeScanningAlertsThreshold(t.AlertsThreshold.ValueString()),
+				SecurityAlertsThreshold: github.CodeScanningSecurityAlertsThreshold(t.SecurityAlertsThreshold.ValueString()),
+				Tool:                    t.Tool.ValueString(),
+			})
+		}
+		r.CodeScanning = &github.CodeScanningRuleParameters{CodeScanningTools: tools}
+	}
+
+	if p := m.FileExtensionRestriction; p != nil {
+		r.FileExtensionRestriction = &github.FileExtensionRestrictionRuleParameters{RestrictedFileExtensions: emptyIfNil(p.RestrictedFileExtensions)}
+	}
+
+	if p := m.FilePathRestriction; p != nil {
+		r.FilePathRestriction = &github.FilePathRestrictionRuleParameters{RestrictedFilePaths: emptyIfNil(p.RestrictedFilePaths)}
+	}
+
+	if p := m.MaxFilePathLength; p != nil {
+		r.MaxFilePathLength = &github.MaxFilePathLengthRuleParameters{MaxFilePathLength: int(p.MaxFilePathLength.ValueInt64())}
+	}
+
+	if p := m.MaxFileSize; p != nil {
+		r.MaxFileSize = &github.MaxFileSizeRuleParameters{MaxFileSize: p.MaxFileSize.ValueInt64()}
+	}
+
+	if p := m.MergeQueue; p != nil {
+		r.MergeQueue = &github.MergeQueueRuleParameters{
+			CheckResponseTimeoutMinutes:  int(p.CheckResponseTimeoutMinutes.ValueInt64()),
+			GroupingStrategy:             github.MergeGroupingStrategy(p.GroupingStrategy.ValueString()),
+			MaxEntriesToBuild:            int(p.MaxEntriesToBuild.ValueInt64()),
+			MaxEntriesToMerge:            int(p.MaxEntriesToMerge.ValueInt64()),
+			MergeMethod:                  github.MergeQueueMergeMethod(p.MergeMethod.ValueString()),
+			MinEntriesToMerge:            int(p.MinEntriesToMerge.ValueInt64()),
+			MinEntriesToMergeWaitMinutes: int(p.MinEntriesToMergeWaitMinutes.ValueInt64()),
+		}
+	}
+
+	if p := m.PullRequest; p != nil {
+		methods := make([]github.PullRequestMergeMethod, 0, len(p.AllowedMergeMethods))
+		for _, mm := range p.AllowedMergeMethods {
+			methods = append(methods, github.PullRequestMergeMethod(mm))
+		}
+
+		r.PullRequest = &github.PullRequestRuleParameters{
+			AllowedMergeMethods:            methods,
+			DismissStaleReviewsOnPush:      p.DismissStaleReviewsOnPush.ValueBool(),
+			RequireCodeOwnerReview:         p.RequireCodeOwnerReview.ValueBool(),
+			RequireLastPushApproval:        p.RequireLastPushApproval.ValueBool(),
+			RequiredApprovingReviewCount:   int(p.RequiredApprovingReviewCount.ValueInt64()),
+			RequiredReviewThreadResolution: p.RequiredReviewThreadResolution.ValueBool(),
+		}
+	}
+
+	if p := m.RequiredDeployments; p != nil {
+		r.RequiredDeployments = &github.RequiredDeploymentsRuleParameters{RequiredDeploymentEnvironments: emptyIfNil(p.Environments)}
+	}
+
+	if p := m.RequiredStatusChecks; p != nil {
+		checks := make([]*github.RuleStatusCheck, 0, len(p.RequiredChecks))
+		for _, c := range p.RequiredChecks {
+			checks = append(checks, &github.RuleStatusCheck{
+				Context:       c.Context.ValueString(),
+				IntegrationID: c.IntegrationID.ValueInt64Pointer(),
+			})
+		}
+
+		r.RequiredStatusChecks = &github.RequiredStatusChecksRuleParameters{
+			DoNotEnforceOnCreate:             p.DoNotEnforceOnCreate.ValueBoolPointer(),
+			RequiredStatusChecks:             checks,
+			StrictRequiredStatusChecksPolicy: p.Strict.ValueBool(),
+		}
+	}
+
+	if p := m.Update; p != nil {
+		r.Update = &github.UpdateRuleParameters{UpdateAllowsFetchAndMerge: p.AllowsFetchAndMerge.ValueBool()}
+	}
+
+	if p := m.Workflows; p != nil {
+		workflows := make([]*github.RuleWorkflow, 0, len(p.Workflows))
+		for _, w := range p.Workflows {
+			workflows = append(workflows, &github.RuleWorkflow{
+				Path:         w.Path.ValueString(),
+				Ref:          w.Ref.ValueStringPointer(),
+				RepositoryID: w.RepositoryID.ValueInt64Pointer(),
+				SHA:          w.SHA.ValueStringPointer(),
+			})
+		}
+
+		r.Workflows = &github.WorkflowsRuleParameters{
+			DoNotEnforceOnCreate: p.DoNotEnforceOnCreate.ValueBoolPointer(),
+			Workflows:            workflows,
+		}
+	}
+
+	return r
+}
+
+func toRulesetPatternRuleModel(p *github.PatternRuleParameters) *RulesetPatternRuleModel {
+	if p == nil {
+		return nil
+	}
+
+	return &RulesetPatternRuleModel{
+		Name:     types.StringPointerValue(p.Name),
+		Negate:   types.BoolValue(p.GetNegate()),
+		Operator: types.StringValue(string(p.Operator)),
+		Pattern:  types.StringValue(p.Pattern),
+	}
+}
+
+func fromRulesetPatternRuleModel(m *RulesetPatternRuleModel) *github.PatternRuleParameters {
+	if m == nil {
+		return nil
+	}
+
+	return &github.PatternRuleParameters{
+		Name:     m.Name.ValueStringPointer(),
+		Negate:   m.Negate.ValueBoolPointer(),
+		Operator: github.PatternRuleOperator(m.Operator.ValueString()),
+		Pattern:  m.Pattern.ValueString(),
+	}
+}
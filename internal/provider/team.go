@@ -3,21 +3,33 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
 )
 
 var (
-	_ resource.Resource              = &TeamResource{}
-	_ resource.ResourceWithConfigure = &TeamResource{}
+	_ resource.Resource                = &TeamResource{}
+	_ resource.ResourceWithConfigure   = &TeamResource{}
+	_ resource.ResourceWithImportState = &TeamResource{}
 )
 
 // NewTeamResource creates a new resource resource.
@@ -32,14 +44,24 @@ type TeamResource struct {
 
 // TeamModel describes the data model.
 type TeamModel struct {
-	Description   types.String `tfsdk:"description"`
-	ID            types.Int64  `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Notifications types.Bool   `tfsdk:"notifications"`
-	Organization  types.String `tfsdk:"organization"`
-	Parent        *TeamModel   `tfsdk:"parent"`
-	Privacy       types.String `tfsdk:"privacy"`
-	Slug          types.String `tfsdk:"slug"`
+	CodeReviewAssignment *TeamCodeReviewAssignmentModel `tfsdk:"code_review_assignment"`
+	Description          types.String                   `tfsdk:"description"`
+	ID                   types.Int64                    `tfsdk:"id"`
+	LDAPDN               types.String                   `tfsdk:"ldap_dn"`
+	Name                 types.String                   `tfsdk:"name"`
+	Notifications        types.Bool                     `tfsdk:"notifications"`
+	Organization         types.String                   `tfsdk:"organization"`
+	OrganizationID       types.Int64                    `tfsdk:"organization_id"`
+	Parent               *TeamModel                     `tfsdk:"parent"`
+	Privacy              types.String                   `tfsdk:"privacy"`
+	Slug                 types.String                   `tfsdk:"slug"`
+}
+
+// TeamCodeReviewAssignmentModel describes the code review assignment data model.
+type TeamCodeReviewAssignmentModel struct {
+	Algorithm   types.String `tfsdk:"algorithm"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+	NotifyTeam  types.Bool   `tfsdk:"notify_team"`
 }
 
 const (
@@ -57,6 +79,36 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The _GitHub_ team resource (`github_team`) allows you to manage teams for a _GitHub_ organization.",
 		Attributes: map[string]schema.Attribute{
+			"code_review_assignment": schema.SingleNestedAttribute{
+				MarkdownDescription: "Code review assignment settings of the team, if set review requests for the team are automatically assigned to team members. The settings are only read from _GitHub_ if they're set or the team is being imported.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"algorithm": schema.StringAttribute{
+						MarkdownDescription: "Algorithm used to choose the reviewers. This can be one of `round_robin` or `load_balance`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("round_robin"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("round_robin", "load_balance"),
+						},
+					},
+					"member_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of team members to assign to each review request.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"notify_team": schema.BoolAttribute{
+						MarkdownDescription: "If the entire team is notified when review requests are assigned to team members.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+				},
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the team.",
 				Optional:            true,
@@ -66,6 +118,16 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Unique identifier of the team.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ldap_dn": schema.StringAttribute{
+				MarkdownDescription: "Distinguished name of the LDAP entry to synchronize the team with, this is only supported by _GitHub Enterprise Server_ with LDAP synchronization enabled. If the distinguished name isn't returned by _GitHub_ the configured value is kept.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the team.",
@@ -80,6 +142,16 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			"organization": schema.StringAttribute{
 				MarkdownDescription: "Name of the organization the team belongs to.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				MarkdownDescription: "Unique identifier of the organization the team belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"parent": schema.SingleNestedAttribute{
 				MarkdownDescription: "Parent team of the team.",
@@ -111,6 +183,9 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			"slug": schema.StringAttribute{
 				MarkdownDescription: "Slug of the team name.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					teamSlugPlanModifier{},
+				},
 			},
 		},
 	}
@@ -145,6 +220,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	n := github.NewTeam{
 		Description: github.Ptr(plan.Description.ValueString()),
+		LDAPDN:      plan.LDAPDN.ValueStringPointer(),
 		Name:        plan.Name.ValueString(),
 		Privacy:     github.Ptr(plan.Privacy.ValueString()),
 	}
@@ -166,9 +242,12 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	if t.GetMembersCount() > 0 {
-		m, _, err := client.Teams.ListTeamMembersBySlug(ctx, plan.Organization.ValueString(), t.GetSlug(), &github.TeamListTeamMembersOptions{})
+		m, err := ghutil.ListAll(func(opts github.ListOptions) ([]*github.User, *github.Response, error) {
+			return client.Teams.ListTeamMembersBySlug(ctx, plan.Organization.ValueString(), t.GetSlug(), &github.TeamListTeamMembersOptions{ListOptions: opts})
+		})
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to get team members.", err.Error())
+			resp.Diagnostics.Append(setPartialTeamState(ctx, &resp.State, plan, t)...)
 			return
 		}
 
@@ -176,27 +255,26 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 			_, err := client.Teams.RemoveTeamMembershipBySlug(ctx, plan.Organization.ValueString(), t.GetSlug(), member.GetLogin())
 			if err != nil {
 				resp.Diagnostics.AddError("Failed to remove team member.", err.Error())
+				resp.Diagnostics.Append(setPartialTeamState(ctx, &resp.State, plan, t)...)
 				return
 			}
 		}
 	}
 
-	state := TeamModel{
-		Description:   types.StringValue(t.GetDescription()),
-		ID:            types.Int64Value(t.GetID()),
-		Name:          types.StringValue(t.GetName()),
-		Notifications: types.BoolValue(t.GetNotificationSetting() == TeamNotificationsEnabled),
-		Organization:  types.StringValue(t.GetOrganization().GetLogin()),
-		Privacy:       types.StringValue(t.GetPrivacy()),
-		Slug:          types.StringValue(t.GetSlug()),
+	if plan.CodeReviewAssignment != nil {
+		if err := setTeamCodeReviewAssignment(ctx, client, t.GetNodeID(), plan.CodeReviewAssignment); err != nil {
+			resp.Diagnostics.AddError("Failed to update team code review assignment.", err.Error())
+			resp.Diagnostics.Append(setPartialTeamState(ctx, &resp.State, plan, t)...)
+			return
+		}
 	}
 
-	if parent := t.GetParent(); parent != nil {
-		state.Parent = &TeamModel{
-			ID:   types.Int64Value(parent.GetID()),
-			Name: types.StringValue(parent.GetName()),
-			Slug: types.StringValue(parent.GetSlug()),
-		}
+	state := toTeamModel(t)
+	state.CodeReviewAssignment = plan.CodeReviewAssignment
+
+	// The LDAP DN is only returned by GitHub Enterprise Server with LDAP synchronization enabled.
+	if state.LDAPDN.IsNull() {
+		state.LDAPDN = plan.LDAPDN
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -215,25 +293,44 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	t, _, err := client.Teams.GetTeamBySlug(ctx, state.Organization.ValueString(), state.Slug.ValueString())
+	var t *github.Team
+	var orgID int64
+	if state.ID.IsNull() {
+		// Imported teams are looked up by slug until the ID is known.
+		t, _, err = client.Teams.GetTeamBySlug(ctx, state.Organization.ValueString(), state.Slug.ValueString())
+	} else {
+		orgID, err = teamOrganizationID(ctx, client, state)
+		if err == nil {
+			t, _, err = client.Teams.GetTeamByID(ctx, orgID, state.ID.ValueInt64())
+		}
+	}
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get team.", err.Error())
 		return
 	}
 
-	state.Description = types.StringValue(t.GetDescription())
-	state.ID = types.Int64Value(t.GetID())
-	state.Name = types.StringValue(t.GetName())
-	state.Notifications = types.BoolValue(t.GetNotificationSetting() == TeamNotificationsEnabled)
-	state.Organization = types.StringValue(t.GetOrganization().GetLogin())
-	state.Privacy = types.StringValue(t.GetPrivacy())
-	state.Slug = types.StringValue(t.GetSlug())
+	prior := state
+	state = toTeamModel(t)
+	if state.OrganizationID.IsNull() && orgID != 0 {
+		state.OrganizationID = types.Int64Value(orgID)
+	}
 
-	if parent := t.GetParent(); parent != nil {
-		state.Parent = &TeamModel{
-			ID:   types.Int64Value(parent.GetID()),
-			Name: types.StringValue(parent.GetName()),
-			Slug: types.StringValue(parent.GetSlug()),
+	// The LDAP DN is only returned by GitHub Enterprise Server with LDAP synchronization enabled.
+	if state.LDAPDN.IsNull() {
+		state.LDAPDN = prior.LDAPDN
+	}
+
+	// The code review assignment settings are only read if they're managed or the team is being imported, so teams without
+	// them don't need the GraphQL API.
+	if prior.CodeReviewAssignment != nil || prior.ID.IsNull() {
+		state.CodeReviewAssignment, err = readTeamCodeReviewAssignment(ctx, client, state.Organization.ValueString(), state.Slug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning("Failed to get team code review assignment.", err.Error())
+			state.CodeReviewAssignment = prior.CodeReviewAssignment
 		}
 	}
 
@@ -247,6 +344,11 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	var prior TeamModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &prior)...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, plan.Organization.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
@@ -255,6 +357,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	n := github.NewTeam{
 		Description: github.Ptr(plan.Description.ValueString()),
+		LDAPDN:      plan.LDAPDN.ValueStringPointer(),
 		Name:        plan.Name.ValueString(),
 		Privacy:     github.Ptr(plan.Privacy.ValueString()),
 	}
@@ -269,30 +372,41 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		n.ParentTeamID = github.Ptr(plan.Parent.ID.ValueInt64())
 	}
 
-	t, _, err := client.Teams.EditTeamBySlug(ctx, plan.Organization.ValueString(), plan.Slug.ValueString(), n, plan.Parent == nil)
+	// An empty LDAP DN removes the LDAP mapping of the team.
+	if plan.LDAPDN.IsNull() && !prior.LDAPDN.IsNull() {
+		n.LDAPDN = github.Ptr("")
+	}
+
+	orgID, err := teamOrganizationID(ctx, client, prior)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to update team.", err.Error())
+		resp.Diagnostics.AddError("Failed to get organization.", err.Error())
 		return
 	}
 
-	state := TeamModel{
-		Description:   types.StringValue(t.GetDescription()),
-		ID:            types.Int64Value(t.GetID()),
-		Name:          types.StringValue(t.GetName()),
-		Notifications: types.BoolValue(t.GetNotificationSetting() == TeamNotificationsEnabled),
-		Organization:  types.StringValue(t.GetOrganization().GetLogin()),
-		Privacy:       types.StringValue(t.GetPrivacy()),
-		Slug:          types.StringValue(t.GetSlug()),
+	t, _, err := client.Teams.EditTeamByID(ctx, orgID, prior.ID.ValueInt64(), n, plan.Parent == nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team.", err.Error())
+		return
 	}
 
-	if parent := t.GetParent(); parent != nil {
-		state.Parent = &TeamModel{
-			ID:   types.Int64Value(parent.GetID()),
-			Name: types.StringValue(parent.GetName()),
-			Slug: types.StringValue(parent.GetSlug()),
+	if !plan.CodeReviewAssignment.Equal(prior.CodeReviewAssignment) {
+		if err := setTeamCodeReviewAssignment(ctx, client, t.GetNodeID(), plan.CodeReviewAssignment); err != nil {
+			resp.Diagnostics.AddError("Failed to update team code review assignment.", err.Error())
+			return
 		}
 	}
 
+	state := toTeamModel(t)
+	state.CodeReviewAssignment = plan.CodeReviewAssignment
+	if state.OrganizationID.IsNull() {
+		state.OrganizationID = types.Int64Value(orgID)
+	}
+
+	// The LDAP DN is only returned by GitHub Enterprise Server with LDAP synchronization enabled.
+	if state.LDAPDN.IsNull() {
+		state.LDAPDN = plan.LDAPDN
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -309,9 +423,215 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	_, err = client.Teams.DeleteTeamBySlug(ctx, state.Organization.ValueString(), state.Slug.ValueString())
+	orgID, err := teamOrganizationID(ctx, client, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get organization.", err.Error())
+		return
+	}
+
+	_, err = client.Teams.DeleteTeamByID(ctx, orgID, state.ID.ValueInt64())
+	if ghutil.IsNotFound(err) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete team.", err.Error())
 		return
 	}
 }
+
+// ImportState imports the resource state.
+func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, slug, err := ghutil.ParseTeam(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), slug)...)
+}
+
+// toTeamModel converts a GitHub team to the resource model.
+func toTeamModel(t *github.Team) TeamModel {
+	m := TeamModel{
+		Description:    types.StringValue(t.GetDescription()),
+		ID:             types.Int64Value(t.GetID()),
+		LDAPDN:         types.StringNull(),
+		Name:           types.StringValue(t.GetName()),
+		Notifications:  types.BoolValue(t.GetNotificationSetting() == TeamNotificationsEnabled),
+		Organization:   types.StringValue(t.GetOrganization().GetLogin()),
+		OrganizationID: types.Int64Null(),
+		Privacy:        types.StringValue(t.GetPrivacy()),
+		Slug:           types.StringValue(t.GetSlug()),
+	}
+
+	if id := t.GetOrganization().GetID(); id != 0 {
+		m.OrganizationID = types.Int64Value(id)
+	}
+
+	if len(t.GetLDAPDN()) != 0 {
+		m.LDAPDN = types.StringValue(t.GetLDAPDN())
+	}
+
+	if parent := t.GetParent(); parent != nil {
+		m.Parent = &TeamModel{
+			ID:   types.Int64Value(parent.GetID()),
+			Name: types.StringValue(parent.GetName()),
+			Slug: types.StringValue(parent.GetSlug()),
+		}
+	}
+
+	return m
+}
+
+// setPartialTeamState sets the state of a team which was created but couldn't be fully configured, so that it's tracked and
+// replaced by the next apply instead of failing because the team already exists.
+func setPartialTeamState(ctx context.Context, state *tfsdk.State, plan TeamModel, t *github.Team) diag.Diagnostics {
+	m := toTeamModel(t)
+	if m.LDAPDN.IsNull() {
+		m.LDAPDN = plan.LDAPDN
+	}
+
+	return state.Set(ctx, &m)
+}
+
+// Equal returns true if both code review assignments are disabled or have the same settings.
+func (m *TeamCodeReviewAssignmentModel) Equal(o *TeamCodeReviewAssignmentModel) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+
+	return m.Algorithm.Equal(o.Algorithm) && m.MemberCount.Equal(o.MemberCount) && m.NotifyTeam.Equal(o.NotifyTeam)
+}
+
+// readTeamCodeReviewAssignment returns the code review assignment settings of a team, or nil if code review assignment isn't
+// enabled. The settings are only available through the GraphQL API.
+func readTeamCodeReviewAssignment(ctx context.Context, client *github.Client, organization, slug string) (*TeamCodeReviewAssignmentModel, error) {
+	const query = `query TeamCodeReviewAssignment($organization: String!, $slug: String!) {
+  organization(login: $organization) {
+    team(slug: $slug) {
+      reviewRequestDelegationEnabled
+      reviewRequestDelegationAlgorithm
+      reviewRequestDelegationMemberCount
+      reviewRequestDelegationNotifyTeam
+    }
+  }
+}`
+
+	var data struct {
+		Organization struct {
+			Team *struct {
+				ReviewRequestDelegationEnabled     bool   `json:"reviewRequestDelegationEnabled"`
+				ReviewRequestDelegationAlgorithm   string `json:"reviewRequestDelegationAlgorithm"`
+				ReviewRequestDelegationMemberCount int64  `json:"reviewRequestDelegationMemberCount"`
+				ReviewRequestDelegationNotifyTeam  bool   `json:"reviewRequestDelegationNotifyTeam"`
+			} `json:"team"`
+		} `json:"organization"`
+	}
+	if err := ghutil.GraphQL(ctx, client, query, map[string]any{"organization": organization, "slug": slug}, &data); err != nil {
+		return nil, err
+	}
+
+	t := data.Organization.Team
+	if t == nil || !t.ReviewRequestDelegationEnabled {
+		return nil, nil
+	}
+
+	return &TeamCodeReviewAssignmentModel{
+		Algorithm:   types.StringValue(strings.ToLower(t.ReviewRequestDelegationAlgorithm)),
+		MemberCount: types.Int64Value(t.ReviewRequestDelegationMemberCount),
+		NotifyTeam:  types.BoolValue(t.ReviewRequestDelegationNotifyTeam),
+	}, nil
+}
+
+// setTeamCodeReviewAssignment updates the code review assignment settings of a team using its GraphQL node ID, a nil model
+// disables code review assignment.
+func setTeamCodeReviewAssignment(ctx context.Context, client *github.Client, nodeID string, m *TeamCodeReviewAssignmentModel) error {
+	const mutation = `mutation UpdateTeamReviewAssignment($input: UpdateTeamReviewAssignmentInput!) {
+  updateTeamReviewAssignment(input: $input) {
+    team {
+      id
+    }
+  }
+}`
+
+	input := map[string]any{"id": nodeID, "enabled": m != nil}
+	if m != nil {
+		input["algorithm"] = strings.ToUpper(m.Algorithm.ValueString())
+		input["teamMemberCount"] = m.MemberCount.ValueInt64()
+		input["notifyTeam"] = m.NotifyTeam.ValueBool()
+	}
+
+	return ghutil.GraphQL(ctx, client, mutation, map[string]any{"input": input}, nil)
+}
+
+// teamOrganizationID returns the ID of the organization of a team from the state, or from the API if it isn't known.
+func teamOrganizationID(ctx context.Context, client *github.Client, m TeamModel) (int64, error) {
+	if !m.OrganizationID.IsNull() && !m.OrganizationID.IsUnknown() {
+		return m.OrganizationID.ValueInt64(), nil
+	}
+
+	return organizationID(ctx, client, m.Organization.ValueString())
+}
+
+// organizationID returns the ID of an organization, which is required by the team ID endpoints.
+func organizationID(ctx context.Context, client *github.Client, organization string) (int64, error) {
+	org, _, err := client.Organizations.Get(ctx, organization)
+	if err != nil {
+		return 0, err
+	}
+
+	return org.GetID(), nil
+}
+
+// teamSlugPlanModifier predicts the slug of a team from its name, the slug from the state is kept if the name hasn't changed. The
+// slug is only predicted for names where the GitHub rules are known, otherwise it's unknown until the team is created or renamed.
+type teamSlugPlanModifier struct{}
+
+var _ planmodifier.String = teamSlugPlanModifier{}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m teamSlugPlanModifier) Description(ctx context.Context) string {
+	return "The slug is predicted from the team name if it only contains ASCII letters, digits, spaces, dashes and underscores."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m teamSlugPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString predicts the slug from the planned team name.
+func (m teamSlugPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var name types.String
+	if resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if name.IsUnknown() || name.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !req.StateValue.IsNull() {
+		var stateName types.String
+		if resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...); resp.Diagnostics.HasError() {
+			return
+		}
+
+		if stateName.Equal(name) {
+			resp.PlanValue = req.StateValue
+			return
+		}
+	}
+
+	slug, ok := ghutil.TeamSlug(name.ValueString())
+	if !ok {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	resp.PlanValue = types.StringValue(slug)
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                = &TeamExternalGroupResource{}
+	_ resource.ResourceWithConfigure   = &TeamExternalGroupResource{}
+	_ resource.ResourceWithImportState = &TeamExternalGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &TeamExternalGroupResource{}
+)
+
+// NewTeamExternalGroupResource creates a new TeamExternalGroupResource.
+func NewTeamExternalGroupResource() resource.Resource {
+	return &TeamExternalGroupResource{}
+}
+
+// TeamExternalGroupResource defines the resource implementation.
+type TeamExternalGroupResource struct {
+	providerData *GitHubProviderData
+}
+
+// TeamExternalGroupModel describes the data model.
+type TeamExternalGroupModel struct {
+	GroupID      types.Int64  `tfsdk:"group_id"`
+	GroupName    types.String `tfsdk:"group_name"`
+	Organization types.String `tfsdk:"organization"`
+	Team         types.String `tfsdk:"team"`
+}
+
+// Metadata returns the resource metadata.
+func (r *TeamExternalGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_team_external_group", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *TeamExternalGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ team external group resource (`github_team_external_group`) allows you to connect a _GitHub_ team in an organization of an enterprise with managed users to an external group from the identity provider; the members of the team are then managed by the identity provider. A team can only be connected to a single external group, and this resource shouldn't be used with `github_team_membership` or `github_team_members` for the same team.",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the external group, which can be found with the `github_external_groups` data source.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"group_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the external group.",
+				Computed:            true,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization the team belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Slug of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *TeamExternalGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ModifyPlan warns if the members of the team are managed by another resource.
+func (r *TeamExternalGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(teamLinkWarnings(ctx, r.providerData, req.Plan, "github_team_external_group")...)
+}
+
+// Create creates the resource.
+func (r *TeamExternalGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamExternalGroupModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	groups, _, err := client.Teams.ListExternalGroupsForTeamBySlug(ctx, organization, plan.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team external group.", err.Error())
+		return
+	}
+	if len(groups.Groups) != 0 {
+		resp.Diagnostics.AddError("Team external group already exists.", fmt.Sprintf("team %q is already connected to external group %q, the connection can be imported", plan.Team.ValueString(), groups.Groups[0].GetGroupName()))
+		return
+	}
+
+	g, _, err := client.Teams.UpdateConnectedExternalGroup(ctx, organization, plan.Team.ValueString(), &github.ExternalGroup{GroupID: plan.GroupID.ValueInt64Pointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create team external group.", err.Error())
+		return
+	}
+
+	plan.GroupName = types.StringValue(g.GetGroupName())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the resource state.
+func (r *TeamExternalGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamExternalGroupModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	groups, _, err := client.Teams.ListExternalGroupsForTeamBySlug(ctx, organization, state.Team.ValueString())
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team external group.", err.Error())
+		return
+	}
+
+	if len(groups.Groups) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.GroupID = types.Int64Value(groups.Groups[0].GetGroupID())
+	state.GroupName = types.StringValue(groups.Groups[0].GetGroupName())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource.
+func (r *TeamExternalGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamExternalGroupModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	g, _, err := client.Teams.UpdateConnectedExternalGroup(ctx, organization, plan.Team.ValueString(), &github.ExternalGroup{GroupID: plan.GroupID.ValueInt64Pointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team external group.", err.Error())
+		return
+	}
+
+	plan.GroupName = types.StringValue(g.GetGroupName())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource.
+func (r *TeamExternalGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamExternalGroupModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	_, err = client.Teams.RemoveConnectedExternalGroup(ctx, organization, state.Team.ValueString())
+	if err != nil && !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete team external group.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *TeamExternalGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, team, err := ghutil.ParseTeam(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+}
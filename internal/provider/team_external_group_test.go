@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamExternalGroupResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization || !accTestConfigData.Features.ExternalGroups {
+		t.Skip("Skipping test because the external groups testing feature isn't enabled")
+	}
+
+	t.Run("create", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+data "github_external_groups" "test" {
+  organization = "%[1]s"
+  display_name = "%[3]s"
+}
+
+resource "github_team_external_group" "test" {
+  organization = "%[1]s"
+  team         = github_team.test.slug
+  group_id     = data.github_external_groups.test.external_groups[0].group_id
+}
+`, accTestConfigData.Values.Organization, teamName, accTestConfigData.Values.ExternalGroup),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_external_group.test", tfjsonpath.New("group_name"), knownvalue.StringExact(accTestConfigData.Values.ExternalGroup)),
+						statecheck.ExpectKnownValue("github_team_external_group.test", tfjsonpath.New("team"), knownvalue.StringExact(teamName)),
+					},
+				},
+				{
+					ResourceName:                         "github_team_external_group.test",
+					ImportState:                          true,
+					ImportStateId:                        fmt.Sprintf("%s:%s", accTestConfigData.Values.Organization, teamName),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "team",
+				},
+			},
+		})
+	})
+}
+
+func TestTeamExternalGroupResourceRead(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		handler     http.HandlerFunc
+		wantRemoved bool
+		wantGroupID int64
+	}{
+		{
+			name:        "connected",
+			handler:     testMockJSON(http.StatusOK, `{"groups":[{"group_id":2,"group_name":"Engineering"}]}`),
+			wantGroupID: 2,
+		},
+		{
+			name:        "disconnected",
+			handler:     testMockJSON(http.StatusOK, `{"groups":[]}`),
+			wantRemoved: true,
+		},
+		{
+			name:        "not_found",
+			handler:     testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`),
+			wantRemoved: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mux := http.NewServeMux()
+			mux.Handle("GET /api/v3/orgs/test-org/teams/test-team/external-groups", tc.handler)
+
+			r := &TeamExternalGroupResource{providerData: testMockProviderData(t, mux)}
+			state := testResourceState(t, r, &TeamExternalGroupModel{
+				GroupID:      types.Int64Value(1),
+				GroupName:    types.StringValue("Old"),
+				Organization: types.StringValue("test-org"),
+				Team:         types.StringValue("test-team"),
+			})
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Fatalf("expected removed %t, got %t", tc.wantRemoved, resp.State.Raw.IsNull())
+			}
+
+			if tc.wantRemoved {
+				return
+			}
+
+			var got TeamExternalGroupModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.GroupID.ValueInt64() != tc.wantGroupID || got.GroupName.ValueString() != "Engineering" {
+				t.Fatalf("expected group %d (Engineering), got %d (%s)", tc.wantGroupID, got.GroupID.ValueInt64(), got.GroupName.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,461 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                   = &TeamIDPSyncResource{}
+	_ resource.ResourceWithConfigure      = &TeamIDPSyncResource{}
+	_ resource.ResourceWithImportState    = &TeamIDPSyncResource{}
+	_ resource.ResourceWithModifyPlan     = &TeamIDPSyncResource{}
+	_ resource.ResourceWithValidateConfig = &TeamIDPSyncResource{}
+)
+
+// NewTeamIDPSyncResource creates a new TeamIDPSyncResource.
+func NewTeamIDPSyncResource() resource.Resource {
+	return &TeamIDPSyncResource{}
+}
+
+// TeamIDPSyncResource defines the resource implementation.
+type TeamIDPSyncResource struct {
+	providerData *GitHubProviderData
+}
+
+// TeamIDPSyncModel describes the data model.
+type TeamIDPSyncModel struct {
+	Groups       []TeamIDPGroupModel `tfsdk:"groups"`
+	Organization types.String        `tfsdk:"organization"`
+	Team         types.String        `tfsdk:"team"`
+}
+
+// TeamIDPGroupModel describes the data model.
+type TeamIDPGroupModel struct {
+	GroupDescription types.String `tfsdk:"group_description"`
+	GroupID          types.String `tfsdk:"group_id"`
+	GroupName        types.String `tfsdk:"group_name"`
+}
+
+// Metadata returns the resource metadata.
+func (r *TeamIDPSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_team_idp_sync", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *TeamIDPSyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ team IdP sync resource (`github_team_idp_sync`) allows you to authoritatively manage the identity provider groups synchronized with a _GitHub_ team using team synchronization; any groups not configured are removed from the team and the members of the team are then managed by the identity provider. This resource shouldn't be used with `github_team_membership` or `github_team_members` for the same team.",
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.SetNestedAttribute{
+				MarkdownDescription: "Set of identity provider groups to synchronize with the team.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_description": schema.StringAttribute{
+							MarkdownDescription: "Description of the identity provider group; defaults to an empty string.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the identity provider group.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"group_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the identity provider group.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization the team belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Slug of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *TeamIDPSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *TeamIDPSyncResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TeamIDPSyncModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, g := range config.Groups {
+		if g.GroupID.IsNull() || g.GroupID.IsUnknown() {
+			continue
+		}
+
+		if seen[g.GroupID.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("groups"), "Duplicate team IdP group.", fmt.Sprintf("group %q can only be configured once", g.GroupID.ValueString()))
+			continue
+		}
+		seen[g.GroupID.ValueString()] = true
+	}
+}
+
+// ModifyPlan warns if the members of the team are managed by another resource.
+func (r *TeamIDPSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(teamLinkWarnings(ctx, r.providerData, req.Plan, "github_team_idp_sync")...)
+}
+
+// Create creates the resource.
+func (r *TeamIDPSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamIDPSyncModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, plan.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	groups, err := setTeamIDPGroups(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Groups)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create team IdP sync.", err.Error())
+		return
+	}
+
+	plan.Groups = groups
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the resource state.
+func (r *TeamIDPSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamIDPSyncModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, state.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	groups, err := readTeamIDPGroups(ctx, client, state.Organization.ValueString(), state.Team.ValueString())
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team IdP sync.", err.Error())
+		return
+	}
+
+	state.Groups = groups
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource.
+func (r *TeamIDPSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamIDPSyncModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, plan.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	groups, err := setTeamIDPGroups(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Groups)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team IdP sync.", err.Error())
+		return
+	}
+
+	plan.Groups = groups
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource.
+func (r *TeamIDPSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamIDPSyncModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, state.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	_, err = setTeamIDPGroups(ctx, client, state.Organization.ValueString(), state.Team.ValueString(), nil)
+	if err != nil && !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete team IdP sync.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *TeamIDPSyncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, team, err := ghutil.ParseTeam(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+}
+
+// readTeamIDPGroups returns the identity provider groups synchronized with a team, sorted by ID.
+func readTeamIDPGroups(ctx context.Context, client *github.Client, organization, team string) ([]TeamIDPGroupModel, error) {
+	list, _, err := client.Teams.ListIDPGroupsForTeamBySlug(ctx, organization, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTeamIDPGroupModels(list.Groups), nil
+}
+
+// setTeamIDPGroups replaces the identity provider groups synchronized with a team and returns the groups; no groups removes the
+// synchronization.
+func setTeamIDPGroups(ctx context.Context, client *github.Client, organization, team string, groups []TeamIDPGroupModel) ([]TeamIDPGroupModel, error) {
+	opts := github.IDPGroupList{Groups: make([]*github.IDPGroup, 0, len(groups))}
+	for _, g := range groups {
+		opts.Groups = append(opts.Groups, &github.IDPGroup{
+			GroupID:          g.GroupID.ValueStringPointer(),
+			GroupName:        g.GroupName.ValueStringPointer(),
+			GroupDescription: g.GroupDescription.ValueStringPointer(),
+		})
+	}
+
+	list, _, err := client.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, organization, team, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTeamIDPGroupModels(list.Groups), nil
+}
+
+func toTeamIDPGroupModels(groups []*github.IDPGroup) []TeamIDPGroupModel {
+	m := make([]TeamIDPGroupModel, 0, len(groups))
+	for _, g := range groups {
+		m = append(m, TeamIDPGroupModel{
+			GroupDescription: types.StringValue(g.GetGroupDescription()),
+			GroupID:          types.StringValue(g.GetGroupID()),
+			GroupName:        types.StringValue(g.GetGroupName()),
+		})
+	}
+
+	slices.SortFunc(m, func(a, b TeamIDPGroupModel) int {
+		return strings.Compare(a.GroupID.ValueString(), b.GroupID.ValueString())
+	})
+
+	return m
+}
+
+// teamSyncPlans records the teams which are planned to have their members managed or to be linked to an identity provider, as
+// a resource can't see the other resources in the configuration but resources planned together share the provider data.
+type teamSyncPlans struct {
+	mu      sync.Mutex
+	members map[string]string
+	links   map[string]string
+	synced  map[string]bool
+}
+
+// planMembers records that the members of the team are managed by the resource type, and returns the resource type planned to
+// link the team to an identity provider or an empty string.
+func (p *teamSyncPlans) planMembers(key, resourceType string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.members == nil {
+		p.members = map[string]string{}
+	}
+	p.members[key] = resourceType
+
+	return p.links[key]
+}
+
+// planLink records that the team is linked to an identity provider by the resource type, and returns the resource type planned
+// to manage the members of the team or an empty string.
+func (p *teamSyncPlans) planLink(key, resourceType string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.links == nil {
+		p.links = map[string]string{}
+	}
+	p.links[key] = resourceType
+
+	return p.members[key]
+}
+
+// syncedTeam returns if the team is known to be synchronized with an identity provider.
+func (p *teamSyncPlans) syncedTeam(key string) (bool, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	synced, ok := p.synced[key]
+	return synced, ok
+}
+
+// setSyncedTeam records if the team is synchronized with an identity provider.
+func (p *teamSyncPlans) setSyncedTeam(key string, synced bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.synced == nil {
+		p.synced = map[string]bool{}
+	}
+	p.synced[key] = synced
+}
+
+// teamSyncPlanKey returns the organization and team from the plan and the key to record them with, the key is empty if the
+// plan is null or the team isn't known yet.
+func teamSyncPlanKey(ctx context.Context, plan tfsdk.Plan) (types.String, types.String, string, diag.Diagnostics) {
+	var organization, team types.String
+	diags := diag.Diagnostics{}
+
+	// The plan is null when the resource is being destroyed.
+	if plan.Raw.IsNull() {
+		return organization, team, "", diags
+	}
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("team"), &team)...)
+	if diags.HasError() || organization.IsUnknown() || team.IsUnknown() {
+		return organization, team, "", diags
+	}
+
+	return organization, team, strings.ToLower(organization.ValueString() + "/" + team.ValueString()), diags
+}
+
+// teamSyncWarnings returns a warning if the team in the plan is connected to an external group or synchronized with identity
+// provider groups, as the members of a synchronized team are managed by the identity provider. Teams linked by a resource
+// planned with the same provider are reported without API requests; otherwise the synchronization endpoints are only checked
+// once per team and only if the resource is new or its team changed. Errors are reported as warnings as the endpoints are only
+// available to organizations which use an identity provider.
+func teamSyncWarnings(ctx context.Context, providerData *GitHubProviderData, state tfsdk.State, plan tfsdk.Plan, resourceType string) diag.Diagnostics {
+	// The provider data is nil before the provider is configured.
+	if providerData == nil {
+		return nil
+	}
+
+	organization, team, key, diags := teamSyncPlanKey(ctx, plan)
+	if diags.HasError() || len(key) == 0 {
+		return diags
+	}
+
+	if linkType := providerData.teamSyncPlans.planMembers(key, resourceType); len(linkType) != 0 {
+		diags.AddWarning("Team is synchronized with an identity provider.", fmt.Sprintf("team %q is linked to an identity provider by %s, so its members are managed by the identity provider and shouldn't be managed by %s", team.ValueString(), linkType, resourceType))
+		return diags
+	}
+
+	if !state.Raw.IsNull() {
+		if _, _, prior, d := teamSyncPlanKey(ctx, tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}); !d.HasError() && prior == key {
+			return diags
+		}
+	}
+
+	synced, ok := providerData.teamSyncPlans.syncedTeam(key)
+	if !ok {
+		client, err := providerData.ClientCreator.OrganizationClient(ctx, organization.ValueString())
+		if err != nil {
+			diags.AddWarning("Failed to create organization client", err.Error())
+			return diags
+		}
+
+		if groups, _, err := client.Teams.ListExternalGroupsForTeamBySlug(ctx, organization.ValueString(), team.ValueString()); err == nil && len(groups.Groups) != 0 {
+			synced = true
+		} else if groups, _, err := client.Teams.ListIDPGroupsForTeamBySlug(ctx, organization.ValueString(), team.ValueString()); err == nil && len(groups.Groups) != 0 {
+			synced = true
+		}
+
+		providerData.teamSyncPlans.setSyncedTeam(key, synced)
+	}
+
+	if synced {
+		diags.AddWarning("Team is synchronized with an identity provider.", fmt.Sprintf("team %q is connected to an external group or synchronized with identity provider groups, so its members are managed by the identity provider and shouldn't be managed by %s", team.ValueString(), resourceType))
+	}
+
+	return diags
+}
+
+// teamLinkWarnings returns a warning if the members of the team in the plan are managed by a resource planned with the same
+// provider, as the members of a team linked to an identity provider are managed by the identity provider.
+func teamLinkWarnings(ctx context.Context, providerData *GitHubProviderData, plan tfsdk.Plan, resourceType string) diag.Diagnostics {
+	// The provider data is nil before the provider is configured.
+	if providerData == nil {
+		return nil
+	}
+
+	_, team, key, diags := teamSyncPlanKey(ctx, plan)
+	if diags.HasError() || len(key) == 0 {
+		return diags
+	}
+
+	if membersType := providerData.teamSyncPlans.planLink(key, resourceType); len(membersType) != 0 {
+		diags.AddWarning("Team members are managed by Terraform.", fmt.Sprintf("team %q has members managed by %s, but its members are managed by the identity provider once it's linked by %s", team.ValueString(), membersType, resourceType))
+	}
+
+	return diags
+}
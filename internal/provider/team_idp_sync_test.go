@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+func TestAccTeamIDPSyncResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization || !accTestConfigData.Features.TeamSync {
+		t.Skip("Skipping test because the team sync testing feature isn't enabled")
+	}
+
+	t.Run("create", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_team_idp_sync" "test" {
+  organization = "%[1]s"
+  team         = github_team.test.slug
+
+  groups = [
+    {
+      group_id   = "%[3]s"
+      group_name = "%[4]s"
+    },
+  ]
+}
+`, accTestConfigData.Values.Organization, teamName, accTestConfigData.Values.IDPGroupID, accTestConfigData.Values.IDPGroupName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_idp_sync.test", tfjsonpath.New("groups"), knownvalue.SetSizeExact(1)),
+						statecheck.ExpectKnownValue("github_team_idp_sync.test", tfjsonpath.New("team"), knownvalue.StringExact(teamName)),
+					},
+				},
+				{
+					ResourceName:                         "github_team_idp_sync.test",
+					ImportState:                          true,
+					ImportStateId:                        fmt.Sprintf("%s:%s", accTestConfigData.Values.Organization, teamName),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "team",
+				},
+			},
+		})
+	})
+
+	t.Run("duplicate_group", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_team_idp_sync" "test" {
+  organization = "%s"
+  team         = "test"
+
+  groups = [
+    {
+      group_id   = "test"
+      group_name = "Test"
+    },
+    {
+      group_id   = "test"
+      group_name = "Other"
+    },
+  ]
+}
+`, accTestConfigData.Values.Organization),
+					ExpectError: regexp.MustCompile(`Duplicate\s+team\s+IdP\s+group`),
+				},
+			},
+		})
+	})
+}
+
+func TestTeamSyncWarnings(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		routes      map[string]http.HandlerFunc
+		destroy     bool
+		unchanged   bool
+		linkedBy    string
+		clientErr   bool
+		wantWarning bool
+		wantCalls   int64
+	}{
+		{
+			name: "external_group",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/teams/test-team/external-groups": testMockJSON(http.StatusOK, `{"groups":[{"group_id":1,"group_name":"Engineering"}]}`),
+			},
+			wantWarning: true,
+			wantCalls:   1,
+		},
+		{
+			name: "idp_groups",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/teams/test-team/external-groups":          testMockJSON(http.StatusForbidden, `{"message":"Forbidden"}`),
+				"GET /api/v3/orgs/test-org/teams/test-team/team-sync/group-mappings": testMockJSON(http.StatusOK, `{"groups":[{"group_id":"a1","group_name":"Engineering"}]}`),
+			},
+			wantWarning: true,
+			wantCalls:   2,
+		},
+		{
+			name: "not_synced",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/teams/test-team/external-groups":          testMockJSON(http.StatusOK, `{"groups":[]}`),
+				"GET /api/v3/orgs/test-org/teams/test-team/team-sync/group-mappings": testMockJSON(http.StatusOK, `{"groups":[]}`),
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "unavailable",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/teams/test-team/external-groups":          testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`),
+				"GET /api/v3/orgs/test-org/teams/test-team/team-sync/group-mappings": testMockJSON(http.StatusForbidden, `{"message":"Forbidden"}`),
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "unchanged",
+			routes: map[string]http.HandlerFunc{
+				"GET /api/v3/orgs/test-org/teams/test-team/external-groups": testMockJSON(http.StatusOK, `{"groups":[{"group_id":1,"group_name":"Engineering"}]}`),
+			},
+			unchanged: true,
+		},
+		{
+			name:        "planned_link",
+			routes:      map[string]http.HandlerFunc{},
+			linkedBy:    "github_team_external_group",
+			wantWarning: true,
+		},
+		{
+			name:        "client_error",
+			routes:      map[string]http.HandlerFunc{},
+			clientErr:   true,
+			wantWarning: true,
+		},
+		{
+			name:    "destroy",
+			routes:  map[string]http.HandlerFunc{},
+			destroy: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var calls atomic.Int64
+			mux := http.NewServeMux()
+			for pattern, handler := range tc.routes {
+				mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+					calls.Add(1)
+					handler(w, r)
+				})
+			}
+
+			r := &TeamMembershipResource{providerData: testMockProviderData(t, mux)}
+			if tc.clientErr {
+				r.providerData.ClientCreator = testErrorClientCreator{}
+			}
+
+			var model any
+			if !tc.destroy {
+				model = &TeamMembershipModel{
+					Organization: types.StringValue("test-org"),
+					Role:         types.StringValue("member"),
+					State:        types.StringUnknown(),
+					Team:         types.StringValue("test-team"),
+					Username:     types.StringValue("test-user"),
+				}
+			}
+			plan := testResourceState(t, r, model)
+
+			prior := testResourceState(t, r, nil)
+			if tc.unchanged {
+				prior = plan
+			}
+
+			if len(tc.linkedBy) != 0 {
+				if diags := teamLinkWarnings(ctx, r.providerData, tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, tc.linkedBy); diags.HasError() || diags.WarningsCount() != 0 {
+					t.Fatalf("unexpected link diagnostics: %v", diags)
+				}
+			}
+
+			// The synchronization endpoints are only checked once per team.
+			for range 2 {
+				diags := teamSyncWarnings(ctx, r.providerData, prior, tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, "github_team_membership")
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+
+				if got := diags.WarningsCount() != 0; got != tc.wantWarning {
+					t.Fatalf("expected warning %t, got diagnostics: %v", tc.wantWarning, diags)
+				}
+			}
+
+			if got := calls.Load(); got != tc.wantCalls {
+				t.Fatalf("expected %d requests, got %d", tc.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestTeamLinkWarnings(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		membersTeam string
+		wantWarning bool
+	}{
+		{
+			name:        "planned_members",
+			membersTeam: "test-team",
+			wantWarning: true,
+		},
+		{
+			name:        "other_team",
+			membersTeam: "other-team",
+		},
+		{
+			name: "no_members",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			providerData := testMockProviderData(t, http.NewServeMux())
+
+			if len(tc.membersTeam) != 0 {
+				m := &TeamMembershipResource{providerData: providerData}
+				plan := testResourceState(t, m, &TeamMembershipModel{
+					Organization: types.StringValue("test-org"),
+					Role:         types.StringValue("member"),
+					State:        types.StringUnknown(),
+					Team:         types.StringValue(tc.membersTeam),
+					Username:     types.StringValue("test-user"),
+				})
+				if diags := teamSyncWarnings(ctx, providerData, testResourceState(t, m, nil), tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, "github_team_membership"); diags.HasError() || diags.WarningsCount() != 0 {
+					t.Fatalf("unexpected membership diagnostics: %v", diags)
+				}
+			}
+
+			r := &TeamExternalGroupResource{providerData: providerData}
+			plan := testResourceState(t, r, &TeamExternalGroupModel{
+				GroupID:      types.Int64Value(1),
+				GroupName:    types.StringUnknown(),
+				Organization: types.StringValue("test-org"),
+				Team:         types.StringValue("test-team"),
+			})
+
+			diags := teamLinkWarnings(ctx, r.providerData, tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, "github_team_external_group")
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got := diags.WarningsCount() != 0; got != tc.wantWarning {
+				t.Fatalf("expected warning %t, got diagnostics: %v", tc.wantWarning, diags)
+			}
+		})
+	}
+}
+
+// testErrorClientCreator is a client creator which fails to create clients.
+type testErrorClientCreator struct {
+	ghutil.ClientCreator
+}
+
+// OrganizationClient returns an error.
+func (testErrorClientCreator) OrganizationClient(ctx context.Context, organization string) (*github.Client, error) {
+	return nil, errors.New("no credentials")
+}
@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                   = &TeamMembersResource{}
+	_ resource.ResourceWithConfigure      = &TeamMembersResource{}
+	_ resource.ResourceWithImportState    = &TeamMembersResource{}
+	_ resource.ResourceWithModifyPlan     = &TeamMembersResource{}
+	_ resource.ResourceWithValidateConfig = &TeamMembersResource{}
+)
+
+// NewTeamMembersResource creates a new resource resource.
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+// TeamMembersResource defines the resource implementation.
+type TeamMembersResource struct {
+	providerData *GitHubProviderData
+}
+
+// TeamMembersResourceModel describes the data model.
+type TeamMembersResourceModel struct {
+	Members      []TeamMemberModel `tfsdk:"members"`
+	Organization types.String      `tfsdk:"organization"`
+	Pending      types.Set         `tfsdk:"pending"`
+	Team         types.String      `tfsdk:"team"`
+}
+
+// Metadata returns the resource metadata.
+func (r *TeamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_team_members", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *TeamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ team members resource (`github_team_members`) allows you to authoritatively manage all of the members of a _GitHub_ team; any members not configured are removed from the team. This resource shouldn't be used with `github_team_membership` for the same team, or for a team managed by `github_team_external_group` or `github_team_idp_sync`.",
+		Attributes: map[string]schema.Attribute{
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "Set of team members, including members whose membership is pending.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the member. Can be `member` or `maintainer`; defaults to `member`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("member"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("member", "maintainer"),
+							},
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Username of the member.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization the team belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pending": schema.SetAttribute{
+				MarkdownDescription: "Set of usernames from `members` whose membership is pending because they haven't accepted their invitation to the organization.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Slug of the team.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *TeamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *TeamMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TeamMembersResourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, m := range config.Members {
+		if m.Username.IsNull() || m.Username.IsUnknown() {
+			continue
+		}
+
+		username := strings.ToLower(m.Username.ValueString())
+		if seen[username] {
+			resp.Diagnostics.AddAttributeError(path.Root("members"), "Duplicate team member.", fmt.Sprintf("user %q can only be configured once", m.Username.ValueString()))
+			continue
+		}
+		seen[username] = true
+	}
+}
+
+// ModifyPlan warns if the team is synchronized with an identity provider.
+func (r *TeamMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(teamSyncWarnings(ctx, r.providerData, req.State, req.Plan, "github_team_members")...)
+}
+
+// Create creates the resource.
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamMembersResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, plan.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	current, _, err := readTeamMembers(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team members.", err.Error())
+		return
+	}
+
+	if resp.Diagnostics.Append(setTeamMembers(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), current, plan.Members)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, pending, err := readTeamMembers(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Members)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team members.", err.Error())
+		return
+	}
+
+	state := TeamMembersResourceModel{
+		Members:      members,
+		Organization: plan.Organization,
+		Pending:      pending,
+		Team:         plan.Team,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read reads the resource state.
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMembersResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, state.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	members, pending, err := readTeamMembers(ctx, client, state.Organization.ValueString(), state.Team.ValueString(), state.Members)
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team members.", err.Error())
+		return
+	}
+
+	state.Members = members
+	state.Pending = pending
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource.
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamMembersResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior TeamMembersResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &prior)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, plan.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	// The prior members provide the roles of pending members so that they aren't added again.
+	current, _, err := readTeamMembers(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), prior.Members)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team members.", err.Error())
+		return
+	}
+
+	if resp.Diagnostics.Append(setTeamMembers(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), current, plan.Members)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, pending, err := readTeamMembers(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Members)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team members.", err.Error())
+		return
+	}
+
+	state := TeamMembersResourceModel{
+		Members:      members,
+		Organization: plan.Organization,
+		Pending:      pending,
+		Team:         plan.Team,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete deletes the resource.
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamMembersResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, state.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	for _, m := range state.Members {
+		_, err := client.Teams.RemoveTeamMembershipBySlug(ctx, state.Organization.ValueString(), state.Team.ValueString(), m.Username.ValueString())
+		if ghutil.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to remove team member.", err.Error())
+			return
+		}
+	}
+}
+
+// ImportState imports the resource state.
+func (r *TeamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, team, err := ghutil.ParseTeam(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+}
+
+// readTeamMembers returns the active and pending members of a team along with the usernames of the pending members. The usernames and
+// the roles of pending members are taken from the known members if they match, as GitHub doesn't return the team role of pending
+// members and usernames are case insensitive.
+func readTeamMembers(ctx context.Context, client *github.Client, organization, team string, known []TeamMemberModel) ([]TeamMemberModel, types.Set, error) {
+	knownByName := make(map[string]TeamMemberModel, len(known))
+	for _, m := range known {
+		knownByName[strings.ToLower(m.Username.ValueString())] = m
+	}
+
+	members, err := listTeamMembers(ctx, client, organization, team)
+	if err != nil {
+		return nil, types.Set{}, err
+	}
+
+	for i, m := range members {
+		if k, ok := knownByName[strings.ToLower(m.Username.ValueString())]; ok {
+			members[i].Username = k.Username
+		}
+	}
+
+	pending := []attr.Value{}
+	for inv, err := range ghutil.Paginate(func(opts github.ListOptions) ([]*github.Invitation, *github.Response, error) {
+		return client.Teams.ListPendingTeamInvitationsBySlug(ctx, organization, team, &opts)
+	}) {
+		if err != nil {
+			return nil, types.Set{}, fmt.Errorf("failed to list pending team invitations: %w", err)
+		}
+
+		if len(inv.GetLogin()) == 0 {
+			continue
+		}
+
+		m := TeamMemberModel{
+			Role:     types.StringValue("member"),
+			Username: types.StringValue(inv.GetLogin()),
+		}
+		if k, ok := knownByName[strings.ToLower(inv.GetLogin())]; ok {
+			m.Role = k.Role
+			m.Username = k.Username
+		}
+
+		members = append(members, m)
+		pending = append(pending, m.Username)
+	}
+
+	slices.SortFunc(members, func(a, b TeamMemberModel) int {
+		return strings.Compare(strings.ToLower(a.Username.ValueString()), strings.ToLower(b.Username.ValueString()))
+	})
+
+	return members, types.SetValueMust(types.StringType, pending), nil
+}
+
+// setTeamMembers converges the current members of a team on the desired members by adding members, changing roles and removing
+// members.
+func setTeamMembers(ctx context.Context, client *github.Client, organization, team string, current, desired []TeamMemberModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	currentByName := make(map[string]TeamMemberModel, len(current))
+	for _, m := range current {
+		currentByName[strings.ToLower(m.Username.ValueString())] = m
+	}
+
+	desiredByName := make(map[string]TeamMemberModel, len(desired))
+	for _, m := range desired {
+		desiredByName[strings.ToLower(m.Username.ValueString())] = m
+	}
+
+	for _, m := range current {
+		if _, ok := desiredByName[strings.ToLower(m.Username.ValueString())]; ok {
+			continue
+		}
+
+		_, err := client.Teams.RemoveTeamMembershipBySlug(ctx, organization, team, m.Username.ValueString())
+		if err != nil {
+			diags.AddError("Failed to remove team member.", err.Error())
+			return diags
+		}
+	}
+
+	for _, m := range desired {
+		if c, ok := currentByName[strings.ToLower(m.Username.ValueString())]; ok && c.Role.Equal(m.Role) {
+			continue
+		}
+
+		_, _, err := client.Teams.AddTeamMembershipBySlug(ctx, organization, team, m.Username.ValueString(), &github.TeamAddTeamMembershipOptions{Role: m.Role.ValueString()})
+		if err != nil {
+			diags.AddError("Failed to add team member.", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamMembersResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("create_update", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		config := func(role string) string {
+			return fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_team_members" "test" {
+  organization = "%[1]s"
+  team         = github_team.test.slug
+
+  members = [
+    {
+      username = "%[3]s"
+      role     = "%[4]s"
+    },
+  ]
+}
+`, accTestConfigData.Values.Organization, teamName, accTestConfigData.Values.Username, role)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config("member"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_members.test", tfjsonpath.New("organization"), knownvalue.StringExact(accTestConfigData.Values.Organization)),
+						statecheck.ExpectKnownValue("github_team_members.test", tfjsonpath.New("team"), knownvalue.StringExact(teamName)),
+						statecheck.ExpectKnownValue("github_team_members.test", tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"role":     knownvalue.StringExact("member"),
+								"username": knownvalue.StringExact(accTestConfigData.Values.Username),
+							}),
+						})),
+						statecheck.ExpectKnownValue("github_team_members.test", tfjsonpath.New("pending"), knownvalue.SetSizeExact(0)),
+					},
+				},
+				{
+					Config: config("maintainer"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_members.test", tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"role":     knownvalue.StringExact("maintainer"),
+								"username": knownvalue.StringExact(accTestConfigData.Values.Username),
+							}),
+						})),
+					},
+				},
+				{
+					ResourceName:                         "github_team_members.test",
+					ImportState:                          true,
+					ImportStateId:                        fmt.Sprintf("%s:%s", accTestConfigData.Values.Organization, teamName),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "team",
+				},
+			},
+		})
+	})
+
+	t.Run("remove_all", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_team_members" "test" {
+  organization = "%[1]s"
+  team         = github_team.test.slug
+  members      = []
+}
+`, accTestConfigData.Values.Organization, teamName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_members.test", tfjsonpath.New("members"), knownvalue.SetSizeExact(0)),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestTeamMembersResourceUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var added []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v3/orgs/test-org/teams/test-team/members", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("role") == "member" {
+			testMockJSON(http.StatusOK, `[{"login":"hubot"}]`)(w, r)
+			return
+		}
+		testMockJSON(http.StatusOK, `[]`)(w, r)
+	})
+	mux.Handle("GET /api/v3/orgs/test-org/teams/test-team/invitations", testMockJSON(http.StatusOK, `[{"login":"octocat"}]`))
+	mux.HandleFunc("PUT /api/v3/orgs/test-org/teams/test-team/memberships/{username}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		added = append(added, r.PathValue("username"))
+		mu.Unlock()
+		testMockJSON(http.StatusOK, `{"role":"member","state":"active"}`)(w, r)
+	})
+
+	r := &TeamMembersResource{providerData: testMockProviderData(t, mux)}
+
+	member := func(username, role string) TeamMemberModel {
+		return TeamMemberModel{Role: types.StringValue(role), Username: types.StringValue(username)}
+	}
+
+	// The pending maintainer is returned as a member by GitHub, so only the prior state knows the role.
+	state := testResourceState(t, r, &TeamMembersResourceModel{
+		Members:      []TeamMemberModel{member("hubot", "member"), member("octocat", "maintainer")},
+		Organization: types.StringValue("test-org"),
+		Pending:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("octocat")}),
+		Team:         types.StringValue("test-team"),
+	})
+	plan := testResourceState(t, r, &TeamMembersResourceModel{
+		Members:      []TeamMemberModel{member("hubot", "member"), member("monalisa", "member"), member("octocat", "maintainer")},
+		Organization: types.StringValue("test-org"),
+		Pending:      types.SetUnknown(types.StringType),
+		Team:         types.StringValue("test-team"),
+	})
+
+	resp := &fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !slices.Equal(added, []string{"monalisa"}) {
+		t.Fatalf("expected only monalisa to be added, got %v", added)
+	}
+}
+
+func TestTeamMembersResourceReadNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v3/orgs/test-org/teams/test-team/members", testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`))
+
+	r := &TeamMembersResource{providerData: testMockProviderData(t, mux)}
+	state := testResourceState(t, r, &TeamMembersResourceModel{
+		Members:      []TeamMemberModel{},
+		Organization: types.StringValue("test-org"),
+		Pending:      types.SetValueMust(types.StringType, []attr.Value{}),
+		Team:         types.StringValue("test-team"),
+	})
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected the team members to be removed from the state")
+	}
+}
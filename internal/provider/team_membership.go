@@ -4,16 +4,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
 )
 
 var (
-	_ resource.Resource              = &TeamMembershipResource{}
-	_ resource.ResourceWithConfigure = &TeamMembershipResource{}
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithConfigure   = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+	_ resource.ResourceWithModifyPlan  = &TeamMembershipResource{}
 )
 
 // NewTeamMembershipResource creates a new resource resource.
@@ -43,7 +48,7 @@ func (d *TeamMembershipResource) Metadata(ctx context.Context, req resource.Meta
 // Schema returns the resource schema.
 func (r *TeamMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "The _GitHub_ team membership resource (`github_team_membership`) allows you to manage membership for a _GitHub_ team.",
+		MarkdownDescription: "The _GitHub_ team membership resource (`github_team_membership`) allows you to manage membership for a _GitHub_ team. This resource shouldn't be used for a team managed by `github_team_external_group` or `github_team_idp_sync`, as the members of a synchronized team are managed by the identity provider.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
 				MarkdownDescription: "Login of the organization the team belongs to.",
@@ -85,6 +90,11 @@ func (r *TeamMembershipResource) Configure(ctx context.Context, req resource.Con
 	r.providerData = providerData
 }
 
+// ModifyPlan warns if the team is synchronized with an identity provider.
+func (r *TeamMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(teamSyncWarnings(ctx, r.providerData, req.State, req.Plan, "github_team_membership")...)
+}
+
 // Create creates the resource.
 func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TeamMembershipModel
@@ -98,11 +108,15 @@ func (r *TeamMembershipResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	_, response, _ := client.Teams.GetTeamMembershipBySlug(ctx, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Username.ValueString())
-	if response.StatusCode != 404 {
+	_, _, err = client.Teams.GetTeamMembershipBySlug(ctx, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Username.ValueString())
+	if err == nil {
 		resp.Diagnostics.AddError("Team membership already exists.", "can't add the same user to the same team multiple times")
 		return
 	}
+	if !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to get team membership.", err.Error())
+		return
+	}
 
 	m, _, err := client.Teams.AddTeamMembershipBySlug(ctx, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Username.ValueString(), &github.TeamAddTeamMembershipOptions{Role: plan.Role.ValueString()})
 	if err != nil {
@@ -135,6 +149,10 @@ func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	m, _, err := client.Teams.GetTeamMembershipBySlug(ctx, state.Organization.ValueString(), state.Team.ValueString(), state.Username.ValueString())
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get team membership.", err.Error())
 		return
@@ -195,3 +213,20 @@ func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 }
+
+// ImportState imports the resource state.
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization", "team_slug", "username")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	organization := parts[0]
+	team := parts[1]
+	username := parts[2]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), username)...)
+}
@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -43,7 +48,119 @@ resource "github_team_membership" "test" {
 						statecheck.ExpectKnownValue("github_team_membership.test", tfjsonpath.New("username"), knownvalue.StringExact(accTestConfigData.Values.Username)),
 					},
 				},
+				{
+					ResourceName:                         "github_team_membership.test",
+					ImportState:                          true,
+					ImportStateId:                        fmt.Sprintf("%s:%s:%s", accTestConfigData.Values.Organization, teamName, accTestConfigData.Values.Username),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "username",
+				},
 			},
 		})
 	})
 }
+
+func TestTeamMembershipResourceRead(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		handler     http.HandlerFunc
+		wantRemoved bool
+		wantRole    string
+		wantState   string
+	}{
+		{
+			name:      "active",
+			handler:   testMockJSON(http.StatusOK, `{"role":"maintainer","state":"active"}`),
+			wantRole:  "maintainer",
+			wantState: "active",
+		},
+		{
+			name:      "pending",
+			handler:   testMockJSON(http.StatusOK, `{"role":"member","state":"pending"}`),
+			wantRole:  "member",
+			wantState: "pending",
+		},
+		{
+			name:        "not_found",
+			handler:     testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`),
+			wantRemoved: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mux := http.NewServeMux()
+			mux.Handle("GET /api/v3/orgs/test-org/teams/test-team/memberships/test-user", tc.handler)
+
+			r := &TeamMembershipResource{providerData: testMockProviderData(t, mux)}
+			state := testResourceState(t, r, &TeamMembershipModel{
+				Organization: types.StringValue("test-org"),
+				Role:         types.StringValue("member"),
+				State:        types.StringValue("active"),
+				Team:         types.StringValue("test-team"),
+				Username:     types.StringValue("test-user"),
+			})
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Fatalf("expected removed %t, got %t", tc.wantRemoved, resp.State.Raw.IsNull())
+			}
+
+			if tc.wantRemoved {
+				return
+			}
+
+			var got TeamMembershipModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.Role.ValueString() != tc.wantRole || got.State.ValueString() != tc.wantState {
+				t.Fatalf("expected role %q and state %q, got %q and %q", tc.wantRole, tc.wantState, got.Role.ValueString(), got.State.ValueString())
+			}
+		})
+	}
+}
+
+func TestTeamMembershipResourceImportState(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		id           string
+		wantOrg      string
+		wantTeam     string
+		wantUsername string
+		wantErr      bool
+	}{
+		{name: "valid", id: "test-org:test-team:test-user", wantOrg: "test-org", wantTeam: "test-team", wantUsername: "test-user"},
+		{name: "missing_username", id: "test-org:test-team", wantErr: true},
+		{name: "empty_organization", id: ":test-team:test-user", wantErr: true},
+		{name: "empty_team", id: "test-org::test-user", wantErr: true},
+		{name: "empty_username", id: "test-org:test-team:", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &TeamMembershipResource{}
+			resp := &fwresource.ImportStateResponse{State: testResourceState(t, r, nil)}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			var org, team, username types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization"), &org)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("team"), &team)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("username"), &username)...)
+			if org.ValueString() != tc.wantOrg || team.ValueString() != tc.wantTeam || username.ValueString() != tc.wantUsername {
+				t.Fatalf("expected %s:%s:%s, got %s:%s:%s", tc.wantOrg, tc.wantTeam, tc.wantUsername, org.ValueString(), team.ValueString(), username.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                   = &TeamRepositoriesResource{}
+	_ resource.ResourceWithConfigure      = &TeamRepositoriesResource{}
+	_ resource.ResourceWithImportState    = &TeamRepositoriesResource{}
+	_ resource.ResourceWithValidateConfig = &TeamRepositoriesResource{}
+)
+
+// NewTeamRepositoriesResource creates a new TeamRepositoriesResource.
+func NewTeamRepositoriesResource() resource.Resource {
+	return &TeamRepositoriesResource{}
+}
+
+// TeamRepositoriesResource defines the resource implementation.
+type TeamRepositoriesResource struct {
+	providerData *GitHubProviderData
+}
+
+// TeamRepositoriesResourceModel describes the data model.
+type TeamRepositoriesResourceModel struct {
+	Organization types.String                `tfsdk:"organization"`
+	Repositories []TeamRepositoryAccessModel `tfsdk:"repositories"`
+	Team         types.String                `tfsdk:"team"`
+}
+
+// TeamRepositoryAccessModel describes the repository access data model.
+type TeamRepositoryAccessModel struct {
+	Permission types.String `tfsdk:"permission"`
+	Repository types.String `tfsdk:"repository"`
+}
+
+// Metadata returns the resource metadata.
+func (r *TeamRepositoriesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_team_repositories", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *TeamRepositoriesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ team repositories resource (`github_team_repositories`) allows you to authoritatively manage all of the repositories a _GitHub_ team has access to; the team's access to any repositories not configured is removed. This resource shouldn't be used with `github_team_repository` for the same team.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization the team belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repositories": schema.SetNestedAttribute{
+				MarkdownDescription: "Set of repositories the team has access to.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": teamRepositoryPermissionAttribute(),
+						"repository": schema.StringAttribute{
+							MarkdownDescription: "Name of the repository.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Slug of the team.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *TeamRepositoriesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *TeamRepositoriesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TeamRepositoriesResourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &config)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, m := range config.Repositories {
+		if m.Repository.IsNull() || m.Repository.IsUnknown() {
+			continue
+		}
+
+		name := strings.ToLower(m.Repository.ValueString())
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(path.Root("repositories"), "Duplicate team repository.", fmt.Sprintf("repository %q can only be configured once", m.Repository.ValueString()))
+			continue
+		}
+		seen[name] = true
+	}
+}
+
+// Create creates the resource.
+func (r *TeamRepositoriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamRepositoriesResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, plan.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	current, err := readTeamRepositories(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team repositories.", err.Error())
+		return
+	}
+
+	if resp.Diagnostics.Append(setTeamRepositories(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), current, plan.Repositories)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	repos, err := readTeamRepositories(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Repositories)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team repositories.", err.Error())
+		return
+	}
+
+	state := TeamRepositoriesResourceModel{
+		Organization: plan.Organization,
+		Repositories: repos,
+		Team:         plan.Team,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read reads the resource state.
+func (r *TeamRepositoriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamRepositoriesResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, state.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	repos, err := readTeamRepositories(ctx, client, state.Organization.ValueString(), state.Team.ValueString(), state.Repositories)
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team repositories.", err.Error())
+		return
+	}
+
+	state.Repositories = repos
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource.
+func (r *TeamRepositoriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamRepositoriesResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, plan.Organization.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	current, err := readTeamRepositories(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team repositories.", err.Error())
+		return
+	}
+
+	if resp.Diagnostics.Append(setTeamRepositories(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), current, plan.Repositories)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	repos, err := readTeamRepositories(ctx, client, plan.Organization.ValueString(), plan.Team.ValueString(), plan.Repositories)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team repositories.", err.Error())
+		return
+	}
+
+	state := TeamRepositoriesResourceModel{
+		Organization: plan.Organization,
+		Repositories: repos,
+		Team:         plan.Team,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete deletes the resource.
+func (r *TeamRepositoriesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamRepositoriesResourceModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	for _, m := range state.Repositories {
+		_, err := client.Teams.RemoveTeamRepoBySlug(ctx, organization, state.Team.ValueString(), organization, m.Repository.ValueString())
+		if ghutil.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to remove team repository.", err.Error())
+			return
+		}
+	}
+}
+
+// ImportState imports the resource state.
+func (r *TeamRepositoriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, team, err := ghutil.ParseTeam(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+}
+
+// readTeamRepositories returns the repositories a team has access to sorted by name. The repository names are taken from the
+// known repositories if they match, as repository names are case insensitive.
+func readTeamRepositories(ctx context.Context, client *github.Client, organization, team string, known []TeamRepositoryAccessModel) ([]TeamRepositoryAccessModel, error) {
+	knownByName := make(map[string]TeamRepositoryAccessModel, len(known))
+	for _, m := range known {
+		knownByName[strings.ToLower(m.Repository.ValueString())] = m
+	}
+
+	repos := []TeamRepositoryAccessModel{}
+	for repo, err := range ghutil.Paginate(func(opts github.ListOptions) ([]*github.Repository, *github.Response, error) {
+		return client.Teams.ListTeamReposBySlug(ctx, organization, team, &opts)
+	}) {
+		if err != nil {
+			return nil, err
+		}
+
+		m := TeamRepositoryAccessModel{
+			Permission: types.StringValue(teamRepositoryPermission(repo.GetRoleName())),
+			Repository: types.StringValue(repo.GetName()),
+		}
+		if k, ok := knownByName[strings.ToLower(repo.GetName())]; ok {
+			m.Repository = k.Repository
+		}
+
+		repos = append(repos, m)
+	}
+
+	slices.SortFunc(repos, func(a, b TeamRepositoryAccessModel) int {
+		return strings.Compare(strings.ToLower(a.Repository.ValueString()), strings.ToLower(b.Repository.ValueString()))
+	})
+
+	return repos, nil
+}
+
+// setTeamRepositories converges the current repositories of a team on the desired repositories by adding repositories, changing
+// permissions and removing repositories.
+func setTeamRepositories(ctx context.Context, client *github.Client, organization, team string, current, desired []TeamRepositoryAccessModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	currentByName := make(map[string]TeamRepositoryAccessModel, len(current))
+	for _, m := range current {
+		currentByName[strings.ToLower(m.Repository.ValueString())] = m
+	}
+
+	desiredByName := make(map[string]TeamRepositoryAccessModel, len(desired))
+	for _, m := range desired {
+		desiredByName[strings.ToLower(m.Repository.ValueString())] = m
+	}
+
+	for _, m := range current {
+		if _, ok := desiredByName[strings.ToLower(m.Repository.ValueString())]; ok {
+			continue
+		}
+
+		_, err := client.Teams.RemoveTeamRepoBySlug(ctx, organization, team, organization, m.Repository.ValueString())
+		if err != nil {
+			diags.AddError("Failed to remove team repository.", err.Error())
+			return diags
+		}
+	}
+
+	for _, m := range desired {
+		if c, ok := currentByName[strings.ToLower(m.Repository.ValueString())]; ok && c.Permission.Equal(m.Permission) {
+			continue
+		}
+
+		_, err := client.Teams.AddTeamRepoBySlug(ctx, organization, team, organization, m.Repository.ValueString(), &github.TeamAddTeamRepoOptions{Permission: m.Permission.ValueString()})
+		if err != nil {
+			diags.AddError("Failed to add team repository.", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamRepositoriesResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("create_update", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+		repoName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		config := func(repositories string) string {
+			return fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_repository" "a" {
+  organization = "%[1]s"
+  name         = "%[3]s-a"
+}
+
+resource "github_repository" "b" {
+  organization = "%[1]s"
+  name         = "%[3]s-b"
+}
+
+resource "github_team_repositories" "test" {
+  organization = "%[1]s"
+  team         = github_team.test.slug
+  repositories = %[4]s
+}
+`, accTestConfigData.Values.Organization, teamName, repoName, repositories)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config(`[
+    { repository = github_repository.a.name },
+    { repository = github_repository.b.name, permission = "push" },
+  ]`),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_repositories.test", tfjsonpath.New("repositories"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"permission": knownvalue.StringExact("pull"),
+								"repository": knownvalue.StringExact(repoName + "-a"),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"permission": knownvalue.StringExact("push"),
+								"repository": knownvalue.StringExact(repoName + "-b"),
+							}),
+						})),
+					},
+				},
+				{
+					Config: config(`[
+    { repository = github_repository.b.name, permission = "admin" },
+  ]`),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_repositories.test", tfjsonpath.New("repositories"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"permission": knownvalue.StringExact("admin"),
+								"repository": knownvalue.StringExact(repoName + "-b"),
+							}),
+						})),
+					},
+				},
+				{
+					ResourceName:                         "github_team_repositories.test",
+					ImportState:                          true,
+					ImportStateId:                        fmt.Sprintf("%s:%s", accTestConfigData.Values.Organization, teamName),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "team",
+				},
+			},
+		})
+	})
+
+	t.Run("remove_all", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_team_repositories" "test" {
+  organization = "%[1]s"
+  team         = github_team.test.slug
+  repositories = []
+}
+`, accTestConfigData.Values.Organization, teamName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_repositories.test", tfjsonpath.New("repositories"), knownvalue.SetSizeExact(0)),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestTeamRepositoriesResourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v3/orgs/test-org/teams/test-team/repos", testMockJSON(http.StatusOK, `[
+		{"name":"test-repo","role_name":"write"},
+		{"name":"another-repo","role_name":"security-reviewer"}
+	]`))
+
+	r := &TeamRepositoriesResource{providerData: testMockProviderData(t, mux)}
+	state := testResourceState(t, r, &TeamRepositoriesResourceModel{
+		Organization: types.StringValue("test-org"),
+		Repositories: []TeamRepositoryAccessModel{
+			{Permission: types.StringValue("pull"), Repository: types.StringValue("Test-Repo")},
+		},
+		Team: types.StringValue("test-team"),
+	})
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got TeamRepositoriesResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+
+	want := []TeamRepositoryAccessModel{
+		{Permission: types.StringValue("security-reviewer"), Repository: types.StringValue("another-repo")},
+		{Permission: types.StringValue("push"), Repository: types.StringValue("Test-Repo")},
+	}
+	if len(got.Repositories) != len(want) {
+		t.Fatalf("expected %d repositories, got %d", len(want), len(got.Repositories))
+	}
+	for i, m := range want {
+		if !got.Repositories[i].Permission.Equal(m.Permission) || !got.Repositories[i].Repository.Equal(m.Repository) {
+			t.Fatalf("expected repository %d to be %v, got %v", i, m, got.Repositories[i])
+		}
+	}
+}
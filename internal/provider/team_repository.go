@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/google/go-github/v74/github"
+
+	"github.com/terr4m/terraform-provider-github/internal/ghutil"
+)
+
+var (
+	_ resource.Resource                = &TeamRepositoryResource{}
+	_ resource.ResourceWithConfigure   = &TeamRepositoryResource{}
+	_ resource.ResourceWithImportState = &TeamRepositoryResource{}
+)
+
+// NewTeamRepositoryResource creates a new TeamRepositoryResource.
+func NewTeamRepositoryResource() resource.Resource {
+	return &TeamRepositoryResource{}
+}
+
+// TeamRepositoryResource defines the resource implementation.
+type TeamRepositoryResource struct {
+	providerData *GitHubProviderData
+}
+
+// TeamRepositoryModel describes the data model.
+type TeamRepositoryModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Permission   types.String `tfsdk:"permission"`
+	Repository   types.String `tfsdk:"repository"`
+	Team         types.String `tfsdk:"team"`
+}
+
+// Metadata returns the resource metadata.
+func (r *TeamRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_team_repository", req.ProviderTypeName)
+}
+
+// Schema returns the resource schema.
+func (r *TeamRepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The _GitHub_ team repository resource (`github_team_repository`) allows you to manage the access of a _GitHub_ team to a repository. This resource shouldn't be used with `github_team_repositories` for the same team.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Login of the organization the team and repository belong to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": teamRepositoryPermissionAttribute(),
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Slug of the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *TeamRepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*GitHubProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected resource provider data.", fmt.Sprintf("expected *GitHubProviderData, got: %T", req.ProviderData))
+		return
+	}
+
+	r.providerData = providerData
+}
+
+// Create creates the resource.
+func (r *TeamRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamRepositoryModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	_, _, err = client.Teams.IsTeamRepoBySlug(ctx, organization, plan.Team.ValueString(), organization, plan.Repository.ValueString())
+	if err == nil {
+		resp.Diagnostics.AddError("Team repository already exists.", "can't add the same repository to the same team multiple times")
+		return
+	}
+	if !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to get team repository.", err.Error())
+		return
+	}
+
+	_, err = client.Teams.AddTeamRepoBySlug(ctx, organization, plan.Team.ValueString(), organization, plan.Repository.ValueString(), &github.TeamAddTeamRepoOptions{Permission: plan.Permission.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create team repository.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the resource state.
+func (r *TeamRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamRepositoryModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	repo, _, err := client.Teams.IsTeamRepoBySlug(ctx, organization, state.Team.ValueString(), organization, state.Repository.ValueString())
+	if ghutil.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get team repository.", err.Error())
+		return
+	}
+
+	state.Permission = types.StringValue(teamRepositoryPermission(repo.GetRoleName()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource.
+func (r *TeamRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamRepositoryModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := plan.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	_, err = client.Teams.AddTeamRepoBySlug(ctx, organization, plan.Team.ValueString(), organization, plan.Repository.ValueString(), &github.TeamAddTeamRepoOptions{Permission: plan.Permission.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update team repository.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource.
+func (r *TeamRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamRepositoryModel
+	if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := state.Organization.ValueString()
+
+	client, err := r.providerData.ClientCreator.OrganizationClient(ctx, organization)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create organization client", err.Error())
+		return
+	}
+
+	_, err = client.Teams.RemoveTeamRepoBySlug(ctx, organization, state.Team.ValueString(), organization, state.Repository.ValueString())
+	if err != nil && !ghutil.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete team repository.", err.Error())
+		return
+	}
+}
+
+// ImportState imports the resource state.
+func (r *TeamRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := ghutil.SplitIdentifier(req.ID, ":", "organization", "team_slug", "repository")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), parts[2])...)
+}
+
+// teamRepositoryPermissionAttribute returns the schema attribute for the permission a team has on a repository.
+func teamRepositoryPermissionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Permission the team has on the repository. Can be `pull`, `triage`, `push`, `maintain`, `admin` or the name of a custom repository role; defaults to `pull`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("pull"),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.NoneOf("read", "write"),
+		},
+	}
+}
+
+// teamRepositoryPermission returns the permission for the role name of a team repository, GitHub returns the "read" and "write"
+// role names for the "pull" and "push" permissions.
+func teamRepositoryPermission(roleName string) string {
+	switch roleName {
+	case "read":
+		return "pull"
+	case "write":
+		return "push"
+	default:
+		return roleName
+	}
+}
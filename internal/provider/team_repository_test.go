@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamRepositoryResource(t *testing.T) {
+	if accTestConfigData.AuthType == accAuthTypeUnauthenticated || !accTestConfigData.Features.Organization {
+		t.Skip("Skipping test because the organization testing feature isn't enabled")
+	}
+
+	t.Run("create_update", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+		repoName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		config := func(permission string) string {
+			return fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_repository" "test" {
+  organization = "%[1]s"
+  name         = "%[3]s"
+}
+
+resource "github_team_repository" "test" {
+  organization = "%[1]s"
+  team         = github_team.test.slug
+  repository   = github_repository.test.name
+  permission   = "%[4]s"
+}
+`, accTestConfigData.Values.Organization, teamName, repoName, permission)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config("pull"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_repository.test", tfjsonpath.New("organization"), knownvalue.StringExact(accTestConfigData.Values.Organization)),
+						statecheck.ExpectKnownValue("github_team_repository.test", tfjsonpath.New("permission"), knownvalue.StringExact("pull")),
+						statecheck.ExpectKnownValue("github_team_repository.test", tfjsonpath.New("repository"), knownvalue.StringExact(repoName)),
+						statecheck.ExpectKnownValue("github_team_repository.test", tfjsonpath.New("team"), knownvalue.StringExact(teamName)),
+					},
+				},
+				{
+					Config: config("maintain"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team_repository.test", tfjsonpath.New("permission"), knownvalue.StringExact("maintain")),
+					},
+				},
+				{
+					ResourceName:                         "github_team_repository.test",
+					ImportState:                          true,
+					ImportStateId:                        fmt.Sprintf("%s:%s:%s", accTestConfigData.Values.Organization, teamName, repoName),
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "repository",
+				},
+			},
+		})
+	})
+
+	t.Run("invalid_permission", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+resource "github_team_repository" "test" {
+  organization = "%s"
+  team         = "test"
+  repository   = "test"
+  permission   = "write"
+}
+`, accTestConfigData.Values.Organization),
+					ExpectError: regexp.MustCompile(`value\s+must\s+be\s+none\s+of`),
+				},
+			},
+		})
+	})
+}
+
+func TestTeamRepositoryResourceRead(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		handler        http.HandlerFunc
+		wantRemoved    bool
+		wantPermission string
+	}{
+		{
+			name:           "read",
+			handler:        testMockJSON(http.StatusOK, `{"name":"test-repo","role_name":"read"}`),
+			wantPermission: "pull",
+		},
+		{
+			name:           "write",
+			handler:        testMockJSON(http.StatusOK, `{"name":"test-repo","role_name":"write"}`),
+			wantPermission: "push",
+		},
+		{
+			name:           "admin",
+			handler:        testMockJSON(http.StatusOK, `{"name":"test-repo","role_name":"admin"}`),
+			wantPermission: "admin",
+		},
+		{
+			name:           "custom",
+			handler:        testMockJSON(http.StatusOK, `{"name":"test-repo","role_name":"security-reviewer"}`),
+			wantPermission: "security-reviewer",
+		},
+		{
+			name:        "not_found",
+			handler:     testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`),
+			wantRemoved: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mux := http.NewServeMux()
+			mux.Handle("GET /api/v3/orgs/test-org/teams/test-team/repos/test-org/test-repo", tc.handler)
+
+			r := &TeamRepositoryResource{providerData: testMockProviderData(t, mux)}
+			state := testResourceState(t, r, &TeamRepositoryModel{
+				Organization: types.StringValue("test-org"),
+				Permission:   types.StringValue("triage"),
+				Repository:   types.StringValue("test-repo"),
+				Team:         types.StringValue("test-team"),
+			})
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Fatalf("expected removed %t, got %t", tc.wantRemoved, resp.State.Raw.IsNull())
+			}
+
+			if tc.wantRemoved {
+				return
+			}
+
+			var got TeamRepositoryModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.Permission.ValueString() != tc.wantPermission {
+				t.Fatalf("expected permission %q, got %q", tc.wantPermission, got.Permission.ValueString())
+			}
+		})
+	}
+}
+
+func TestTeamRepositoryResourceImportState(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		id       string
+		wantOrg  string
+		wantTeam string
+		wantRepo string
+		wantErr  bool
+	}{
+		{name: "valid", id: "test-org:test-team:test-repo", wantOrg: "test-org", wantTeam: "test-team", wantRepo: "test-repo"},
+		{name: "missing_repository", id: "test-org:test-team", wantErr: true},
+		{name: "empty_team", id: "test-org::test-repo", wantErr: true},
+		{name: "empty_repository", id: "test-org:test-team:", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &TeamRepositoryResource{}
+			resp := &fwresource.ImportStateResponse{State: testResourceState(t, r, nil)}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			var org, team, repo types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization"), &org)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("team"), &team)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("repository"), &repo)...)
+			if org.ValueString() != tc.wantOrg || team.ValueString() != tc.wantTeam || repo.ValueString() != tc.wantRepo {
+				t.Fatalf("expected %s:%s:%s, got %s:%s:%s", tc.wantOrg, tc.wantTeam, tc.wantRepo, org.ValueString(), team.ValueString(), repo.ValueString())
+			}
+		})
+	}
+}
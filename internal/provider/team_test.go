@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -32,8 +40,10 @@ resource "github_team" "test" {
 }
 `, accTestConfigData.Values.Organization, teamName),
 					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("code_review_assignment"), knownvalue.Null()),
 						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("description"), knownvalue.StringExact("")),
 						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("ldap_dn"), knownvalue.Null()),
 						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("name"), knownvalue.StringExact(teamName)),
 						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("notifications"), knownvalue.Bool(true)),
 						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("organization"), knownvalue.StringExact(accTestConfigData.Values.Organization)),
@@ -42,6 +52,12 @@ resource "github_team" "test" {
 						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("slug"), knownvalue.StringExact(teamName)),
 					},
 				},
+				{
+					ResourceName:      "github_team.test",
+					ImportState:       true,
+					ImportStateId:     fmt.Sprintf("%s:%s", accTestConfigData.Values.Organization, teamName),
+					ImportStateVerify: true,
+				},
 			},
 		})
 	})
@@ -81,6 +97,67 @@ resource "github_team" "test" {
 		})
 	})
 
+	t.Run("code_review_assignment", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+
+		config := func(assignment string) string {
+			return fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+%s
+}
+`, accTestConfigData.Values.Organization, teamName, assignment)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config(`
+  code_review_assignment = {}
+`),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("code_review_assignment"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"algorithm":    knownvalue.StringExact("round_robin"),
+							"member_count": knownvalue.Int64Exact(1),
+							"notify_team":  knownvalue.Bool(true),
+						})),
+					},
+				},
+				{
+					ResourceName:      "github_team.test",
+					ImportState:       true,
+					ImportStateId:     fmt.Sprintf("%s:%s", accTestConfigData.Values.Organization, teamName),
+					ImportStateVerify: true,
+				},
+				{
+					Config: config(`
+  code_review_assignment = {
+    algorithm    = "load_balance"
+    member_count = 2
+    notify_team  = false
+  }
+`),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("code_review_assignment"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"algorithm":    knownvalue.StringExact("load_balance"),
+							"member_count": knownvalue.Int64Exact(2),
+							"notify_team":  knownvalue.Bool(false),
+						})),
+					},
+				},
+				{
+					Config: config(""),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("code_review_assignment"), knownvalue.Null()),
+					},
+				},
+			},
+		})
+	})
+
 	t.Run("already_exists", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			PreCheck:                 func() { testAccPreCheck(t) },
@@ -98,4 +175,469 @@ resource "github_team" "test" {
 			},
 		})
 	})
+
+	t.Run("rename", func(t *testing.T) {
+		teamName := fmt.Sprintf("%s%s", accTestConfigData.ResourcePrefix, acctest.RandomWithPrefix("test"))
+		newTeamName := fmt.Sprintf("%s Renamed", teamName)
+
+		config := func(name string) string {
+			return fmt.Sprintf(`
+resource "github_team" "test" {
+  organization = "%s"
+  name         = "%s"
+}
+
+resource "github_team_membership" "test" {
+  organization = "%[1]s"
+  team         = github_team.test.slug
+  username     = "%[3]s"
+}
+`, accTestConfigData.Values.Organization, name, accTestConfigData.Values.Username)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:                 func() { testAccPreCheck(t) },
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config(teamName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("slug"), knownvalue.StringExact(teamName)),
+					},
+				},
+				{
+					Config: config(newTeamName),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("name"), knownvalue.StringExact(newTeamName)),
+						statecheck.ExpectKnownValue("github_team.test", tfjsonpath.New("slug"), knownvalue.StringExact(fmt.Sprintf("%s-renamed", teamName))),
+						statecheck.ExpectKnownValue("github_team_membership.test", tfjsonpath.New("team"), knownvalue.StringExact(fmt.Sprintf("%s-renamed", teamName))),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestTeamResourceRead(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		id             types.Int64
+		handler        http.HandlerFunc
+		ldapDN         types.String
+		orgID          types.Int64
+		codeReview     *TeamCodeReviewAssignmentModel
+		graphql        http.HandlerFunc
+		wantRemoved    bool
+		wantWarning    bool
+		wantName       string
+		wantSlug       string
+		wantLDAPDN     types.String
+		wantCodeReview *TeamCodeReviewAssignmentModel
+	}{
+		{
+			name:     "found",
+			id:       types.Int64Value(1),
+			handler:  testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			wantName: "Test Team",
+			wantSlug: "test-team",
+		},
+		{
+			name:     "organization_id",
+			id:       types.Int64Value(1),
+			orgID:    types.Int64Value(10),
+			handler:  testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			wantName: "Test Team",
+			wantSlug: "test-team",
+		},
+		{
+			name:     "renamed",
+			id:       types.Int64Value(1),
+			handler:  testMockJSON(http.StatusOK, `{"id":1,"name":"Renamed Team","slug":"renamed-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			wantName: "Renamed Team",
+			wantSlug: "renamed-team",
+		},
+		{
+			name:       "ldap_dn",
+			id:         types.Int64Value(1),
+			handler:    testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","ldap_dn":"cn=test,ou=teams,dc=example,dc=com","organization":{"login":"test-org"}}`),
+			wantName:   "Test Team",
+			wantSlug:   "test-team",
+			wantLDAPDN: types.StringValue("cn=test,ou=teams,dc=example,dc=com"),
+		},
+		{
+			name:       "ldap_dn_not_returned",
+			id:         types.Int64Value(1),
+			handler:    testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			ldapDN:     types.StringValue("cn=test,ou=teams,dc=example,dc=com"),
+			wantName:   "Test Team",
+			wantSlug:   "test-team",
+			wantLDAPDN: types.StringValue("cn=test,ou=teams,dc=example,dc=com"),
+		},
+		{
+			name:    "code_review_assignment",
+			id:      types.Int64Value(1),
+			handler: testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			codeReview: &TeamCodeReviewAssignmentModel{
+				Algorithm:   types.StringValue("round_robin"),
+				MemberCount: types.Int64Value(1),
+				NotifyTeam:  types.BoolValue(true),
+			},
+			graphql:  testMockJSON(http.StatusOK, `{"data":{"organization":{"team":{"reviewRequestDelegationEnabled":true,"reviewRequestDelegationAlgorithm":"LOAD_BALANCE","reviewRequestDelegationMemberCount":2,"reviewRequestDelegationNotifyTeam":false}}}}`),
+			wantName: "Test Team",
+			wantSlug: "test-team",
+			wantCodeReview: &TeamCodeReviewAssignmentModel{
+				Algorithm:   types.StringValue("load_balance"),
+				MemberCount: types.Int64Value(2),
+				NotifyTeam:  types.BoolValue(false),
+			},
+		},
+		{
+			name:    "code_review_assignment_disabled",
+			id:      types.Int64Value(1),
+			handler: testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			codeReview: &TeamCodeReviewAssignmentModel{
+				Algorithm:   types.StringValue("round_robin"),
+				MemberCount: types.Int64Value(1),
+				NotifyTeam:  types.BoolValue(true),
+			},
+			graphql:  testMockJSON(http.StatusOK, `{"data":{"organization":{"team":{"reviewRequestDelegationEnabled":false,"reviewRequestDelegationAlgorithm":"ROUND_ROBIN","reviewRequestDelegationMemberCount":1,"reviewRequestDelegationNotifyTeam":true}}}}`),
+			wantName: "Test Team",
+			wantSlug: "test-team",
+		},
+		{
+			name:    "code_review_assignment_error",
+			id:      types.Int64Value(1),
+			handler: testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			codeReview: &TeamCodeReviewAssignmentModel{
+				Algorithm:   types.StringValue("round_robin"),
+				MemberCount: types.Int64Value(1),
+				NotifyTeam:  types.BoolValue(true),
+			},
+			graphql:     testMockJSON(http.StatusOK, `{"errors":[{"message":"Field 'reviewRequestDelegationEnabled' doesn't exist on type 'Team'"}]}`),
+			wantWarning: true,
+			wantName:    "Test Team",
+			wantSlug:    "test-team",
+			wantCodeReview: &TeamCodeReviewAssignmentModel{
+				Algorithm:   types.StringValue("round_robin"),
+				MemberCount: types.Int64Value(1),
+				NotifyTeam:  types.BoolValue(true),
+			},
+		},
+		{
+			name:     "imported",
+			id:       types.Int64Null(),
+			handler:  testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			graphql:  testMockJSON(http.StatusOK, `{"data":{"organization":{"team":{"reviewRequestDelegationEnabled":false,"reviewRequestDelegationAlgorithm":"ROUND_ROBIN","reviewRequestDelegationMemberCount":1,"reviewRequestDelegationNotifyTeam":true}}}}`),
+			wantName: "Test Team",
+			wantSlug: "test-team",
+		},
+		{
+			name:        "imported_code_review_assignment_error",
+			id:          types.Int64Null(),
+			handler:     testMockJSON(http.StatusOK, `{"id":1,"name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`),
+			graphql:     testMockJSON(http.StatusOK, `{"errors":[{"message":"Field 'reviewRequestDelegationEnabled' doesn't exist on type 'Team'"}]}`),
+			wantWarning: true,
+			wantName:    "Test Team",
+			wantSlug:    "test-team",
+		},
+		{
+			name:        "not_found",
+			id:          types.Int64Value(1),
+			handler:     testMockJSON(http.StatusNotFound, `{"message":"Not Found"}`),
+			wantRemoved: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// The organization ID is only looked up if it isn't in the state; imported teams are read by slug.
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v3/orgs/test-org", func(w http.ResponseWriter, r *http.Request) {
+				if !tc.orgID.IsNull() || tc.id.IsNull() {
+					t.Error("unexpected organization request")
+				}
+				testMockJSON(http.StatusOK, `{"id":10,"login":"test-org"}`)(w, r)
+			})
+			if tc.id.IsNull() {
+				mux.Handle("GET /api/v3/orgs/test-org/teams/test-team", tc.handler)
+			} else {
+				mux.Handle("GET /api/v3/organizations/10/team/1", tc.handler)
+			}
+			if tc.graphql != nil {
+				mux.Handle("POST /api/graphql", tc.graphql)
+			} else {
+				mux.HandleFunc("POST /api/graphql", func(w http.ResponseWriter, r *http.Request) {
+					t.Error("unexpected GraphQL request")
+					w.WriteHeader(http.StatusInternalServerError)
+				})
+			}
+
+			r := &TeamResource{providerData: testMockProviderData(t, mux)}
+			state := testResourceState(t, r, &TeamModel{
+				CodeReviewAssignment: tc.codeReview,
+				Description:          types.StringValue(""),
+				ID:                   tc.id,
+				LDAPDN:               tc.ldapDN,
+				Name:                 types.StringValue("Test Team"),
+				Notifications:        types.BoolValue(true),
+				Organization:         types.StringValue("test-org"),
+				OrganizationID:       tc.orgID,
+				Privacy:              types.StringValue("closed"),
+				Slug:                 types.StringValue("test-team"),
+			})
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.wantWarning {
+				t.Fatalf("expected warning %t, got %v", tc.wantWarning, resp.Diagnostics)
+			}
+
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Fatalf("expected removed %t, got %t", tc.wantRemoved, resp.State.Raw.IsNull())
+			}
+
+			if tc.wantRemoved {
+				return
+			}
+
+			var got TeamModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.ID.ValueInt64() != 1 || got.Name.ValueString() != tc.wantName || got.Slug.ValueString() != tc.wantSlug {
+				t.Fatalf("expected team 1 %q (%s), got %d %q (%s)", tc.wantName, tc.wantSlug, got.ID.ValueInt64(), got.Name.ValueString(), got.Slug.ValueString())
+			}
+
+			if !tc.id.IsNull() && got.OrganizationID.ValueInt64() != 10 {
+				t.Fatalf("expected organization ID 10, got %s", got.OrganizationID)
+			}
+
+			if !got.LDAPDN.Equal(tc.wantLDAPDN) {
+				t.Fatalf("expected LDAP DN %s, got %s", tc.wantLDAPDN, got.LDAPDN)
+			}
+
+			if !got.CodeReviewAssignment.Equal(tc.wantCodeReview) {
+				t.Fatalf("expected code review assignment %+v, got %+v", tc.wantCodeReview, got.CodeReviewAssignment)
+			}
+		})
+	}
+}
+
+func TestTeamResourceLDAPDN(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		update bool
+		body   string
+		ldapDN types.String
+	}{
+		{
+			name:   "create",
+			body:   `{"id":1,"node_id":"T_1","name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","ldap_dn":"cn=test,ou=teams,dc=example,dc=com","organization":{"login":"test-org"}}`,
+			ldapDN: types.StringValue("cn=test,ou=teams,dc=example,dc=com"),
+		},
+		{
+			name:   "create_not_returned",
+			body:   `{"id":1,"node_id":"T_1","name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`,
+			ldapDN: types.StringValue("cn=test,ou=teams,dc=example,dc=com"),
+		},
+		{
+			name:   "create_null",
+			body:   `{"id":1,"node_id":"T_1","name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`,
+			ldapDN: types.StringNull(),
+		},
+		{
+			name:   "update_not_returned",
+			update: true,
+			body:   `{"id":1,"node_id":"T_1","name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`,
+			ldapDN: types.StringValue("cn=test,ou=teams,dc=example,dc=com"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mux := http.NewServeMux()
+			mux.Handle("GET /api/v3/orgs/test-org", testMockJSON(http.StatusOK, `{"id":10,"login":"test-org"}`))
+			mux.Handle("POST /api/v3/orgs/test-org/teams", testMockJSON(http.StatusCreated, tc.body))
+			mux.Handle("PATCH /api/v3/organizations/10/team/1", testMockJSON(http.StatusOK, tc.body))
+
+			r := &TeamResource{providerData: testMockProviderData(t, mux)}
+
+			m := TeamModel{
+				Description:   types.StringValue(""),
+				ID:            types.Int64Unknown(),
+				LDAPDN:        tc.ldapDN,
+				Name:          types.StringValue("Test Team"),
+				Notifications: types.BoolValue(true),
+				Organization:  types.StringValue("test-org"),
+				Privacy:       types.StringValue("closed"),
+				Slug:          types.StringUnknown(),
+			}
+			if tc.update {
+				m.ID = types.Int64Value(1)
+				m.Slug = types.StringValue("test-team")
+			}
+			plan := testResourceState(t, r, &m)
+
+			var state tfsdk.State
+			var diags diag.Diagnostics
+			if tc.update {
+				resp := &fwresource.UpdateResponse{State: plan}
+				r.Update(ctx, fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}, State: plan}, resp)
+				state, diags = resp.State, resp.Diagnostics
+			} else {
+				resp := &fwresource.CreateResponse{State: testResourceState(t, r, nil)}
+				r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+				state, diags = resp.State, resp.Diagnostics
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			var got TeamModel
+			if diags := state.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("unexpected state diagnostics: %v", diags)
+			}
+
+			if !got.LDAPDN.Equal(tc.ldapDN) {
+				t.Fatalf("expected LDAP DN %s, got %s", tc.ldapDN, got.LDAPDN)
+			}
+		})
+	}
+}
+
+func TestTeamResourceCreatePartial(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.Handle("POST /api/v3/orgs/test-org/teams", testMockJSON(http.StatusCreated, `{"id":1,"node_id":"T_1","name":"Test Team","slug":"test-team","privacy":"closed","notification_setting":"notifications_enabled","organization":{"login":"test-org"}}`))
+	mux.Handle("POST /api/graphql", testMockJSON(http.StatusOK, `{"errors":[{"message":"Resource not accessible by integration"}]}`))
+
+	r := &TeamResource{providerData: testMockProviderData(t, mux)}
+	plan := testResourceState(t, r, &TeamModel{
+		CodeReviewAssignment: &TeamCodeReviewAssignmentModel{
+			Algorithm:   types.StringValue("round_robin"),
+			MemberCount: types.Int64Value(1),
+			NotifyTeam:  types.BoolValue(true),
+		},
+		Description:   types.StringValue(""),
+		ID:            types.Int64Unknown(),
+		Name:          types.StringValue("Test Team"),
+		Notifications: types.BoolValue(true),
+		Organization:  types.StringValue("test-org"),
+		Privacy:       types.StringValue("closed"),
+		Slug:          types.StringUnknown(),
+	})
+
+	resp := &fwresource.CreateResponse{State: testResourceState(t, r, nil)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error")
+	}
+
+	// The team exists even if the code review assignment failed, so it must be in the state.
+	var got TeamModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %v", diags)
+	}
+	if got.ID.ValueInt64() != 1 || got.Slug.ValueString() != "test-team" || got.CodeReviewAssignment != nil {
+		t.Fatalf("expected team 1 (test-team) without code review assignment, got %d (%s)", got.ID.ValueInt64(), got.Slug.ValueString())
+	}
+}
+
+func TestTeamSlugPlanModifier(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		stateName string
+		stateSlug string
+		planName  string
+		want      types.String
+	}{
+		{name: "create", planName: "My Team", want: types.StringValue("my-team")},
+		{name: "create_underscore", planName: "my_team -- 2", want: types.StringValue("my_team-2")},
+		{name: "rename", stateName: "My Team", stateSlug: "my-team", planName: "New Team", want: types.StringValue("new-team")},
+		{name: "unchanged", stateName: "Café Team", stateSlug: "cafe-team", planName: "Café Team", want: types.StringValue("cafe-team")},
+		{name: "empty_slug", planName: "🚀", want: types.StringUnknown()},
+		{name: "cjk", planName: "開発チーム", want: types.StringUnknown()},
+		{name: "non_ascii", planName: "Café Team", want: types.StringUnknown()},
+		{name: "rename_non_ascii", stateName: "My Team", stateSlug: "my-team", planName: "Équipe", want: types.StringUnknown()},
+		{name: "punctuation", planName: "R&D", want: types.StringUnknown()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &TeamResource{}
+
+			plan := testResourceState(t, r, &TeamModel{
+				Name:         types.StringValue(tc.planName),
+				Organization: types.StringValue("test-org"),
+				Slug:         types.StringUnknown(),
+			})
+
+			req := planmodifier.StringRequest{
+				Path:       path.Root("slug"),
+				Plan:       tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				PlanValue:  types.StringUnknown(),
+				State:      testResourceState(t, r, nil),
+				StateValue: types.StringNull(),
+			}
+			if len(tc.stateName) != 0 {
+				req.State = testResourceState(t, r, &TeamModel{
+					Name:         types.StringValue(tc.stateName),
+					Organization: types.StringValue("test-org"),
+					Slug:         types.StringValue(tc.stateSlug),
+				})
+				req.StateValue = types.StringValue(tc.stateSlug)
+			}
+
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			teamSlugPlanModifier{}.PlanModifyString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Fatalf("expected slug %s, got %s", tc.want, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestTeamResourceImportState(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		id       string
+		wantOrg  string
+		wantSlug string
+		wantErr  bool
+	}{
+		{name: "valid", id: "test-org:test-team", wantOrg: "test-org", wantSlug: "test-team"},
+		{name: "missing_slug", id: "test-org", wantErr: true},
+		{name: "empty_organization", id: ":test-team", wantErr: true},
+		{name: "empty_slug", id: "test-org:", wantErr: true},
+		{name: "too_many_parts", id: "test-org:test-team:extra", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := &TeamResource{}
+			resp := &fwresource.ImportStateResponse{State: testResourceState(t, r, nil)}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.wantErr, resp.Diagnostics)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			var org, slug types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization"), &org)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("slug"), &slug)...)
+			if org.ValueString() != tc.wantOrg || slug.ValueString() != tc.wantSlug {
+				t.Fatalf("expected %s:%s, got %s:%s", tc.wantOrg, tc.wantSlug, org.ValueString(), slug.ValueString())
+			}
+		})
+	}
 }